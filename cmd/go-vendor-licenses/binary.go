@@ -0,0 +1,295 @@
+/*
+ * go-vendor-licenses - binary.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"debug/buildinfo"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+
+	licenses "github.com/tq-systems/go-vendor-licenses/licenses"
+)
+
+// proxyDirs returns the local GOPROXY directories modules not in the module
+// cache are taken from: the one given by -goproxy, else the file:// entries
+// of GOPROXY.
+func proxyDirs() []string {
+	if *goproxyFlag != "" {
+		return []string{*goproxyFlag}
+	}
+	dirs := []string{}
+	for _, entry := range strings.FieldsFunc(os.Getenv("GOPROXY"), func(r rune) bool {
+		return r == ',' || r == '|'
+	}) {
+		if strings.HasPrefix(entry, "file://") {
+			dirs = append(dirs, filepath.FromSlash(strings.TrimPrefix(entry, "file://")))
+		}
+	}
+	return dirs
+}
+
+// hash1 computes the h1: hash of the files of a module, as listed in go.sum
+// and the build info. The names are prefixed with the module path and
+// version, like in module zip files.
+func hash1(names []string, open func(name string) (io.ReadCloser, error)) (string, error) {
+	sort.Strings(names)
+	summary := sha256.New()
+	for _, name := range names {
+		r, err := open(name)
+		if err != nil {
+			return "", err
+		}
+		h := sha256.New()
+		_, err = io.Copy(h, r)
+		r.Close()
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(summary, "%x  %s\n", h.Sum(nil), name)
+	}
+	return "h1:" + base64.StdEncoding.EncodeToString(summary.Sum(nil)), nil
+}
+
+// hashZip computes the h1: hash of a module zip file.
+func hashZip(file string) (string, error) {
+	z, err := zip.OpenReader(file)
+	if err != nil {
+		return "", err
+	}
+	defer z.Close()
+
+	files := map[string]*zip.File{}
+	names := []string{}
+	for _, f := range z.File {
+		files[f.Name] = f
+		names = append(names, f.Name)
+	}
+	return hash1(names, func(name string) (io.ReadCloser, error) {
+		return files[name].Open()
+	})
+}
+
+// hashDir computes the h1: hash of the extracted module m in dir.
+func hashDir(dir string, m modVersion) (string, error) {
+	prefix := m.path + "@" + m.version + "/"
+	names := []string{}
+	err := filepath.Walk(dir, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("%s: not a regular file", file)
+		}
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		names = append(names, prefix+filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hash1(names, func(name string) (io.ReadCloser, error) {
+		return os.Open(filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(name, prefix))))
+	})
+}
+
+// moduleExtractDir returns the directory modules taken from zip files are
+// extracted to. It is private to the user, unlike the temporary directory.
+func moduleExtractDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "go-vendor-licenses-modules"), nil
+}
+
+// extractZip extracts a module zip file to dir. It is extracted to a
+// temporary directory renamed at last, so dir is complete if it exists.
+func extractZip(file string, m modVersion, dir string) error {
+	z, err := zip.OpenReader(file)
+	if err != nil {
+		return err
+	}
+	defer z.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dir), 0700); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempDir(filepath.Dir(dir), ".extract")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	prefix := m.path + "@" + m.version + "/"
+	for _, f := range z.File {
+		if !strings.HasPrefix(f.Name, prefix) || strings.HasSuffix(f.Name, "/") {
+			continue
+		}
+		name := filepath.FromSlash(strings.TrimPrefix(f.Name, prefix))
+		if strings.HasPrefix(filepath.Clean(name), "..") {
+			return fmt.Errorf("%s: invalid file name %s", file, f.Name)
+		}
+		target := filepath.Join(tmp, name)
+		if err := os.MkdirAll(filepath.Dir(target), 0700); err != nil {
+			return err
+		}
+		if err := extractZipFile(f, target); err != nil {
+			return err
+		}
+	}
+	return os.Rename(tmp, dir)
+}
+
+func extractZipFile(f *zip.File, target string) error {
+	r, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	w, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// locateModule returns the directory of the sources of the module version,
+// from the module cache or extracted from a zip file of a local GOPROXY
+// directory, whose hash must match sum if given. Modules extracted before
+// are verified again. It returns an empty directory if the module is not
+// found.
+func locateModule(m modVersion, sum string) (string, error) {
+	if dir := modCachePath(licenses.ModuleCacheDir(), m); isDir(dir) {
+		return dir, nil
+	}
+
+	extractDir, err := moduleExtractDir()
+	if err != nil {
+		return "", err
+	}
+	escaped := filepath.FromSlash(escapeModPath(m.path))
+	extracted := filepath.Join(extractDir, escaped+"@"+escapeModPath(m.version))
+	if isDir(extracted) {
+		if sum == "" {
+			return extracted, nil
+		}
+		if hash, err := hashDir(extracted, m); err == nil && hash == sum {
+			return extracted, nil
+		}
+		// Extract the module again
+		if err := os.RemoveAll(extracted); err != nil {
+			return "", err
+		}
+	}
+	for _, proxy := range proxyDirs() {
+		file := filepath.Join(proxy, escaped, "@v", escapeModPath(m.version)+".zip")
+		if _, err := os.Stat(file); os.IsNotExist(err) {
+			continue
+		}
+		if sum != "" {
+			hash, err := hashZip(file)
+			if err != nil {
+				return "", err
+			}
+			if hash != sum {
+				return "", fmt.Errorf("%s: checksum mismatch, %s instead of %s", file, hash, sum)
+			}
+		}
+		if err := extractZip(file, m, extracted); err != nil {
+			return "", err
+		}
+		return extracted, nil
+	}
+	return "", nil
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// readBinaryModules reads the modules a Go binary is built of from its
+// embedded build info and locates their sources. The main module is taken
+// from the current directory, if that is it. Modules whose sources are not
+// found are kept without path, so identifying their licenses fails. The Go
+// release it is built with is returned too.
+func readBinaryModules(file string) ([]metadata, string, error) {
+	info, err := buildinfo.ReadFile(file)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ret := []metadata{}
+	if cwd, err := os.Getwd(); err == nil {
+		if mainPath, err := readGoModPath(filepath.Join(cwd, "go.mod")); err == nil && mainPath == info.Main.Path {
+			ret = append(ret, metadata{name: info.Main.Path, path: cwd, main: true})
+		}
+	}
+	if len(ret) == 0 {
+		fmt.Fprintf(os.Stderr, "skipping main module %s: run in its directory to include it\n", info.Main.Path)
+	}
+
+	for _, dep := range info.Deps {
+		meta, err := binaryModule(dep)
+		if err != nil {
+			return nil, "", err
+		}
+		if meta.path == "" {
+			fmt.Fprintf(os.Stderr, "%s@%s: not found in the module cache or GOPROXY directory\n",
+				dep.Path, dep.Version)
+		}
+		ret = append(ret, meta)
+	}
+	return ret, info.GoVersion, nil
+}
+
+func binaryModule(dep *debug.Module) (metadata, error) {
+	meta := metadata{
+		name:    dep.Path,
+		version: dep.Version,
+		sum:     dep.Sum,
+	}
+	source := dep
+	if dep.Replace != nil {
+		source = dep.Replace
+		if source.Version == "" || source.Version == "(devel)" {
+			// Directory replacements are only found relative to the
+			// current directory
+			meta.replace = source.Path
+			if isDir(source.Path) {
+				meta.path, _ = filepath.Abs(source.Path)
+			}
+			return meta, nil
+		}
+		meta.replace = source.Path + " " + source.Version
+		meta.sum = source.Sum
+	}
+
+	dir, err := locateModule(modVersion{source.Path, source.Version}, source.Sum)
+	meta.path = dir
+	return meta, err
+}
@@ -0,0 +1,119 @@
+/*
+ * go-vendor-licenses - binary_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeModuleZip writes a module zip file of m with the files.
+func writeModuleZip(t *testing.T, file string, m modVersion, files map[string]string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	for name, content := range files {
+		entry, err := w.Create(m.path + "@" + m.version + "/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := entry.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHashZipMatchesExtractedDir(t *testing.T) {
+	m := modVersion{"example.com/Mod", "v1.0.0"}
+	file := filepath.Join(t.TempDir(), "v1.0.0.zip")
+	writeModuleZip(t, file, m, map[string]string{
+		"LICENSE":   "license text\n",
+		"go.mod":    "module example.com/Mod\n",
+		"sub/a.go":  "package sub\n",
+		"README.md": "readme\n",
+	})
+
+	zipHash, err := hashZip(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(t.TempDir(), "example.com", "!mod@v1.0.0")
+	if err := extractZip(file, m, dir); err != nil {
+		t.Fatal(err)
+	}
+	dirHash, err := hashDir(dir, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zipHash != dirHash {
+		t.Errorf("hash of extracted module %s, want %s", dirHash, zipHash)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "LICENSE"), []byte("planted\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if tampered, err := hashDir(dir, m); err != nil || tampered == zipHash {
+		t.Errorf("hash of tampered module %s, %v", tampered, err)
+	}
+}
+
+func TestLocateModuleVerifiesExtractions(t *testing.T) {
+	os.Setenv("GOMODCACHE", t.TempDir())
+	defer os.Unsetenv("GOMODCACHE")
+	cache := t.TempDir()
+	os.Setenv("XDG_CACHE_HOME", cache)
+	defer os.Unsetenv("XDG_CACHE_HOME")
+	proxy := t.TempDir()
+	*goproxyFlag = proxy
+	defer func() { *goproxyFlag = "" }()
+
+	m := modVersion{"example.com/mod", "v1.0.0"}
+	file := filepath.Join(proxy, "example.com", "mod", "@v", "v1.0.0.zip")
+	writeModuleZip(t, file, m, map[string]string{"LICENSE": "license text\n"})
+	sum, err := hashZip(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := locateModule(m, "h1:invalid="); err == nil {
+		t.Errorf("module with wrong checksum located")
+	}
+	dir, err := locateModule(m, sum)
+	if err != nil || dir == "" {
+		t.Fatalf("module not located: %v", err)
+	}
+	if info, err := os.Stat(filepath.Dir(dir)); err != nil || info.Mode().Perm() != 0700 {
+		t.Errorf("extraction directory not private: %v", info.Mode())
+	}
+
+	// A planted module is replaced by the verified one
+	if err := ioutil.WriteFile(filepath.Join(dir, "LICENSE"), []byte("planted\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	dir, err = locateModule(m, sum)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "LICENSE"))
+	if err != nil || string(data) != "license text\n" {
+		t.Errorf("planted module used: %q, %v", data, err)
+	}
+}
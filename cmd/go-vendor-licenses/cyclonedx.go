@@ -0,0 +1,306 @@
+/*
+ * go-vendor-licenses - cyclonedx.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/tq-systems/go-vendor-licenses/licenses"
+)
+
+const cdxSpecVersion = "1.5"
+
+type cdxBOM struct {
+	XMLName      xml.Name        `json:"-" xml:"http://cyclonedx.org/schema/bom/1.5 bom"`
+	BOMFormat    string          `json:"bomFormat" xml:"-"`
+	SpecVersion  string          `json:"specVersion" xml:"-"`
+	SerialNumber string          `json:"serialNumber" xml:"serialNumber,attr"`
+	Version      int             `json:"version" xml:"version,attr"`
+	Metadata     cdxMetadata     `json:"metadata" xml:"metadata"`
+	Components   []cdxComponent  `json:"components" xml:"components>component"`
+	Dependencies []cdxDependency `json:"dependencies" xml:"dependencies>dependency"`
+}
+
+type cdxMetadata struct {
+	Timestamp string       `json:"timestamp" xml:"timestamp"`
+	Tools     cdxTools     `json:"tools" xml:"tools"`
+	Component cdxComponent `json:"component" xml:"component"`
+}
+
+type cdxTools struct {
+	Components []cdxComponent `json:"components" xml:"components>component"`
+}
+
+// cdxComponent is a component of the BOM. The fields are in the order of
+// the XML schema, which requires it.
+type cdxComponent struct {
+	Type     string      `json:"type" xml:"type,attr"`
+	BOMRef   string      `json:"bom-ref,omitempty" xml:"bom-ref,attr,omitempty"`
+	Name     string      `json:"name" xml:"name"`
+	Version  string      `json:"version,omitempty" xml:"version,omitempty"`
+	Licenses cdxLicenses `json:"licenses,omitempty" xml:"licenses,omitempty"`
+	PURL     string      `json:"purl,omitempty" xml:"purl,omitempty"`
+	// Components are the sub-components, like the other modules of a
+	// workspace
+	Components cdxComponents `json:"components,omitempty" xml:"components,omitempty"`
+	Evidence   *cdxEvidence  `json:"evidence,omitempty" xml:"evidence,omitempty"`
+}
+
+// cdxComponents is a list of sub-components, which is omitted if empty.
+type cdxComponents []cdxComponent
+
+// MarshalXML is implemented as nested tags ("components>component") would
+// be written for empty lists, too.
+func (components cdxComponents) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, component := range components {
+		err := e.EncodeElement(component, xml.StartElement{Name: xml.Name{Local: "component"}})
+		if err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// cdxLicense is either a license or, if Expression is set, an SPDX license
+// expression.
+type cdxLicense struct {
+	Expression string        `json:"-" xml:"-"`
+	ID         string        `json:"id,omitempty" xml:"id,omitempty"`
+	Name       string        `json:"name,omitempty" xml:"name,omitempty"`
+	Properties cdxProperties `json:"properties,omitempty" xml:"properties,omitempty"`
+}
+
+// cdxLicenses is serialized as list of license choices in JSON, which wrap
+// every license in an object of its own.
+type cdxLicenses []cdxLicense
+
+func (licenses cdxLicenses) MarshalJSON() ([]byte, error) {
+	type choice struct {
+		License    *cdxLicense `json:"license,omitempty"`
+		Expression string      `json:"expression,omitempty"`
+	}
+	choices := []choice{}
+	for k := range licenses {
+		if licenses[k].Expression != "" {
+			choices = append(choices, choice{Expression: licenses[k].Expression})
+		} else {
+			choices = append(choices, choice{License: &licenses[k]})
+		}
+	}
+	return json.Marshal(choices)
+}
+
+// MarshalXML is implemented as nested tags ("licenses>license") would be
+// written for empty lists, too.
+func (licenses cdxLicenses) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, license := range licenses {
+		var err error
+		if license.Expression != "" {
+			err = e.EncodeElement(license.Expression, xml.StartElement{Name: xml.Name{Local: "expression"}})
+		} else {
+			err = e.EncodeElement(license, xml.StartElement{Name: xml.Name{Local: "license"}})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+type cdxProperty struct {
+	Name  string `json:"name" xml:"name,attr"`
+	Value string `json:"value" xml:",chardata"`
+}
+
+type cdxProperties []cdxProperty
+
+func (properties cdxProperties) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, property := range properties {
+		err := e.EncodeElement(property, xml.StartElement{Name: xml.Name{Local: "property"}})
+		if err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// cdxEvidence is the evidence of the licenses of a component, in the order
+// of the XML schema.
+type cdxEvidence struct {
+	Occurrences []cdxOccurrence `json:"occurrences,omitempty" xml:"occurrences>occurrence,omitempty"`
+	Licenses    cdxLicenses     `json:"licenses,omitempty" xml:"licenses,omitempty"`
+}
+
+type cdxOccurrence struct {
+	Location string `json:"location" xml:"location"`
+}
+
+type cdxDependency struct {
+	Ref       string  `json:"ref" xml:"ref,attr"`
+	DependsOn cdxRefs `json:"dependsOn,omitempty" xml:"dependency,omitempty"`
+}
+
+type cdxRef struct {
+	Ref string `xml:"ref,attr"`
+}
+
+// cdxRefs is serialized as plain list of references in JSON.
+type cdxRefs []cdxRef
+
+func (refs cdxRefs) MarshalJSON() ([]byte, error) {
+	list := []string{}
+	for _, ref := range refs {
+		list = append(list, ref.Ref)
+	}
+	return json.Marshal(list)
+}
+
+// purl returns the package URL of a Go module.
+func purl(meta metadata) string {
+	segments := strings.Split(meta.name, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+	ref := "pkg:golang/" + strings.Join(segments, "/")
+
+	pkgVersion := meta.version
+	if pkgVersion == "" {
+		pkgVersion = meta.revision
+	}
+	if pkgVersion != "" {
+		// '+' is not escaped in paths, but is reserved in package URLs
+		ref += "@" + strings.Replace(url.PathEscape(pkgVersion), "+", "%2B", -1)
+	}
+	return ref
+}
+
+func cdxModuleComponent(meta metadata, componentType string) cdxComponent {
+	component := cdxComponent{
+		Type:    componentType,
+		BOMRef:  purl(meta),
+		Name:    meta.name,
+		Version: meta.version,
+		PURL:    purl(meta),
+	}
+	if component.Version == "" {
+		component.Version = meta.revision
+	}
+
+	result := meta.detected
+	if result == nil {
+		return component
+	}
+
+	evidence := cdxEvidence{}
+	for _, license := range result.Licenses {
+		identified := cdxLicense{Name: license.Template.Title}
+		if licenses.IsSPDXLicenseID(license.Template.SPDXID) {
+			identified = cdxLicense{ID: license.Template.SPDXID}
+		}
+		identified.Properties = cdxProperties{{
+			Name:  "go-vendor-licenses:score",
+			Value: fmt.Sprintf("%.2f", license.Score),
+		}}
+		evidence.Licenses = append(evidence.Licenses, identified)
+		evidence.Occurrences = append(evidence.Occurrences, cdxOccurrence{Location: license.Path})
+	}
+	component.Evidence = &evidence
+
+	// Only identifiers of the SPDX license list are valid ids, others are
+	// valid in expressions if they are license references
+	switch {
+	case result.Expression == "":
+	case licenses.IsSPDXLicenseID(result.Expression):
+		component.Licenses = cdxLicenses{{ID: result.Expression}}
+	case !strings.Contains(result.Expression, " ") && !strings.HasPrefix(result.Expression, "LicenseRef-"):
+		component.Licenses = cdxLicenses{{Name: result.Expression}}
+	default:
+		component.Licenses = cdxLicenses{{Expression: result.Expression}}
+	}
+	return component
+}
+
+func buildCycloneDXBOM(manifest []metadata) cdxBOM {
+	root := rootModule(manifest)
+
+	bom := cdxBOM{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  cdxSpecVersion,
+		SerialNumber: "urn:uuid:" + newUUID(),
+		Version:      1,
+		Metadata: cdxMetadata{
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+			Tools: cdxTools{
+				Components: []cdxComponent{{
+					Type:    "application",
+					Name:    "go-vendor-licenses",
+					Version: version,
+				}},
+			},
+			Component: cdxModuleComponent(root, "application"),
+		},
+		Components:   []cdxComponent{},
+		Dependencies: []cdxDependency{},
+	}
+
+	// Other main modules of a workspace are parts of the root, not libraries
+	// it depends on
+	rootDependency := cdxDependency{Ref: bom.Metadata.Component.BOMRef}
+	for _, meta := range manifest {
+		if meta.main && meta.name == root.name {
+			continue
+		}
+		if meta.main {
+			component := cdxModuleComponent(meta, "application")
+			bom.Metadata.Component.Components = append(bom.Metadata.Component.Components, component)
+			bom.Dependencies = append(bom.Dependencies, cdxDependency{Ref: component.BOMRef})
+			continue
+		}
+		component := cdxModuleComponent(meta, "library")
+		bom.Components = append(bom.Components, component)
+		bom.Dependencies = append(bom.Dependencies, cdxDependency{Ref: component.BOMRef})
+		rootDependency.DependsOn = append(rootDependency.DependsOn, cdxRef{Ref: component.BOMRef})
+	}
+	bom.Dependencies = append([]cdxDependency{rootDependency}, bom.Dependencies...)
+
+	return bom
+}
+
+func writeCycloneDXJSON(w io.Writer, manifest []metadata) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(buildCycloneDXBOM(manifest))
+}
+
+func writeCycloneDXXML(w io.Writer, manifest []metadata) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(buildCycloneDXBOM(manifest)); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
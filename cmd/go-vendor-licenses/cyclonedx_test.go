@@ -0,0 +1,178 @@
+/*
+ * go-vendor-licenses - cyclonedx_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tq-systems/go-vendor-licenses/licenses"
+)
+
+// cdxTestManifest is a manifest of a workspace with a dependency, whose
+// licenses are identified.
+func cdxTestManifest(expression string, templates ...*licenses.Template) []metadata {
+	result := &licenses.Result{Expression: expression}
+	for _, template := range templates {
+		result.Licenses = append(result.Licenses, &licenses.License{
+			Score:    1,
+			Template: template,
+			Path:     "/mod/LICENSE-" + template.Nickname,
+		})
+	}
+	return []metadata{
+		{name: "example.com/a", path: "/ws/a", main: true},
+		{name: "example.com/b", path: "/ws/b", main: true, detected: result},
+		{name: "github.com/pkg/errors", version: "v0.9.1", detected: result},
+	}
+}
+
+// childElements returns the names of the child elements of every element
+// named parent in the XML document.
+func childElements(t *testing.T, document []byte, parent string) [][]string {
+	t.Helper()
+	children := [][]string{}
+	// the stack holds the names of the open elements
+	stack := []string{}
+	decoder := xml.NewDecoder(bytes.NewReader(document))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return children
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			if len(stack) > 0 && stack[len(stack)-1] == parent {
+				children[len(children)-1] = append(children[len(children)-1], token.Name.Local)
+			}
+			if token.Name.Local == parent {
+				children = append(children, []string{})
+			}
+			stack = append(stack, token.Name.Local)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+func TestCycloneDXXMLFollowsSchemaOrder(t *testing.T) {
+	mit := &licenses.Template{Title: "MIT License", Nickname: "MIT", SPDXID: "MIT"}
+	buffer := &bytes.Buffer{}
+	if err := writeCycloneDXXML(buffer, cdxTestManifest("MIT", mit)); err != nil {
+		t.Fatal(err)
+	}
+
+	// The sequences of the CycloneDX 1.5 schema
+	components := []string{"name", "version", "licenses", "purl", "components", "evidence"}
+	evidence := []string{"occurrences", "licenses"}
+	order := func(sequence []string, elements []string) bool {
+		k := 0
+		for _, element := range elements {
+			for k < len(sequence) && sequence[k] != element {
+				k++
+			}
+			if k == len(sequence) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, elements := range childElements(t, buffer.Bytes(), "component") {
+		if !order(components, elements) {
+			t.Errorf("component elements %q, want the order %q", elements, components)
+		}
+	}
+	found := childElements(t, buffer.Bytes(), "evidence")
+	if len(found) != 2 {
+		t.Errorf("%d components with evidence, want 2", len(found))
+	}
+	for _, elements := range found {
+		if !reflect.DeepEqual(elements, evidence) {
+			t.Errorf("evidence elements %q, want %q", elements, evidence)
+		}
+	}
+}
+
+func TestCycloneDXLicenseIDs(t *testing.T) {
+	mit := &licenses.Template{Title: "MIT License", Nickname: "MIT", SPDXID: "MIT"}
+	none := &licenses.Template{Title: "No License", Nickname: "NOLICENSE", SPDXID: "NONE"}
+	custom := &licenses.Template{Title: "TQ-Systems License", Nickname: "TQSSLA", SPDXID: "LicenseRef-TQSSLA"}
+	bogus := &licenses.Template{Title: "Bogus-1.0", Nickname: "Bogus-1.0", SPDXID: "Bogus-1.0"}
+	tests := []struct {
+		name      string
+		manifest  []metadata
+		licenses  cdxLicenses
+		evidences []string
+	}{
+		{"spdx", cdxTestManifest("MIT", mit),
+			cdxLicenses{{ID: "MIT"}}, []string{"id MIT"}},
+		{"none", cdxTestManifest("NONE", none),
+			cdxLicenses{{Name: "NONE"}}, []string{"name No License"}},
+		{"license reference", cdxTestManifest("LicenseRef-TQSSLA", custom),
+			cdxLicenses{{Expression: "LicenseRef-TQSSLA"}}, []string{"name TQ-Systems License"}},
+		{"unknown tag", cdxTestManifest("Bogus-1.0", bogus),
+			cdxLicenses{{Name: "Bogus-1.0"}}, []string{"name Bogus-1.0"}},
+		{"expression", cdxTestManifest("MIT AND LicenseRef-TQSSLA", mit, custom),
+			cdxLicenses{{Expression: "MIT AND LicenseRef-TQSSLA"}}, []string{"id MIT", "name TQ-Systems License"}},
+	}
+	for _, test := range tests {
+		bom := buildCycloneDXBOM(test.manifest)
+		component := bom.Components[0]
+		if !reflect.DeepEqual(component.Licenses, test.licenses) {
+			t.Errorf("%s: licenses %+v, want %+v", test.name, component.Licenses, test.licenses)
+		}
+		evidences := []string{}
+		for _, license := range component.Evidence.Licenses {
+			if license.ID != "" {
+				evidences = append(evidences, "id "+license.ID)
+			} else {
+				evidences = append(evidences, "name "+license.Name)
+			}
+		}
+		if !reflect.DeepEqual(evidences, test.evidences) {
+			t.Errorf("%s: evidence %q, want %q", test.name, evidences, test.evidences)
+		}
+	}
+}
+
+func TestPURL(t *testing.T) {
+	tests := []struct {
+		meta metadata
+		purl string
+	}{
+		{metadata{name: "github.com/pkg/errors", version: "v0.9.1"}, "pkg:golang/github.com/pkg/errors@v0.9.1"},
+		{metadata{name: "github.com/Azure/go-ansiterm", version: "v0.0.0-20210617225240-d185dfc1b5a1"},
+			"pkg:golang/github.com/Azure/go-ansiterm@v0.0.0-20210617225240-d185dfc1b5a1"},
+		{metadata{name: "example.com/m", version: "v2.0.0+incompatible"}, "pkg:golang/example.com/m@v2.0.0%2Bincompatible"},
+		{metadata{name: "example.com/m", revision: "abcdef"}, "pkg:golang/example.com/m@abcdef"},
+		{metadata{name: "example.com/main"}, "pkg:golang/example.com/main"},
+	}
+	for _, test := range tests {
+		if ref := purl(test.meta); ref != test.purl {
+			t.Errorf("purl of %s: %s, want %s", test.meta.name, ref, test.purl)
+		}
+	}
+}
+
+func TestCycloneDXJSONLicenseChoices(t *testing.T) {
+	data, err := cdxLicenses{{ID: "MIT"}, {Expression: "MIT OR Apache-2.0"}}.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"license":{"id":"MIT"}},{"expression":"MIT OR Apache-2.0"}]`
+	if strings.TrimSpace(string(data)) != want {
+		t.Errorf("licenses %s, want %s", data, want)
+	}
+}
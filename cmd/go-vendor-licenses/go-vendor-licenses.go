@@ -11,6 +11,7 @@ package main
 import (
 	"bufio"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
@@ -21,6 +22,7 @@ import (
 	"runtime"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	licenses "github.com/tq-systems/go-vendor-licenses/licenses"
 )
@@ -34,17 +36,72 @@ type metadata struct {
 	version  string
 	branch   string
 	license  string
+	main     bool
+	// replace is the replacement of the module, a module path and version or
+	// a directory
+	replace string
+	// explicit is set for modules required by go.mod, in vendored builds
+	explicit bool
+	// sum is the checksum of the module, as listed in go.sum
+	sum string
+	// resolveErr is set if the module could not be resolved completely
+	resolveErr error
+	// packages are the packages given on the command line, which are built
+	// with the module
+	packages []string
+	// targets are the platforms the module is built for, if several are
+	// scanned
+	targets   []string
+	detected  *licenses.Result
+	verdict   licenses.Verdict
+	exception *licenses.Exception
+	critical  bool
 }
 
+// Exit codes of the tool. The codes of the problems found with the licenses
+// are combined (bitwise OR), so all of them can be told apart.
+const (
+	// exitError is used for failures of the tool itself
+	exitError = 1
+	// exitUsage is used for invalid usage, like the flag package does for
+	// invalid flags
+	exitUsage         = 2
+	exitNoLicense     = 4
+	exitUnreadable    = 8
+	exitLowConfidence = 16
+	exitDenied        = 32
+)
+
 const (
 	gopkgFile = "Gopkg.lock"
+	// cache entries not used within this time are removed by "cache prune"
+	cachePruneAge = 30 * 24 * time.Hour
 )
 
 var (
-	ignoreCritLicsFlag = flag.Bool("i", false, "ignore missing or copyleft licenses")
-	vendorFlag         = flag.Bool("vendor", false, "use vendored versions of dependant Go modules")
+	ignoreCritLicsFlag = flag.Bool("i", false, "ignore missing, unidentified or denied licenses")
+	vendorFlag         = flag.Bool("vendor", false, "use vendored versions of dependant Go modules, as listed in vendor/modules.txt")
+	noGoFlag           = flag.Bool("no-go", false, "read go.mod and the module cache without the go command, which is done too if it is not installed")
 	manifestFlag       = flag.Bool("m", false, "display manifest of dependant packages")
 	disclaimerFlag     = flag.Bool("d", false, "display disclaimer of dependant packages")
+	obligationsFlag    = flag.Bool("obligations", false, "display obligations of the licenses of dependant packages")
+	noticesFlag        = flag.Bool("notices", false, "display third party notices of dependant packages, grouped by license")
+	outputFlag         = flag.String("o", "", "write the output to `FILE` instead of stdout")
+	jobsFlag           = flag.Int("j", runtime.GOMAXPROCS(0), "number of packages to scan in parallel")
+	cacheFlag          = flag.String("cache", "", "directory of the license cache (default: user cache directory)")
+	noCacheFlag        = flag.Bool("no-cache", false, "do not use the license cache")
+	srcCopyrightsFlag  = flag.Bool("source-copyrights", false, "collect copyrights from the headers of Go files too")
+	spdxTagsFlag       = flag.Bool("spdx-tags", false, "cross-check license files with SPDX-License-Identifier tags in Go files")
+	templatesFlag      = flag.String("templates", "", "directory with additional license templates (*.txt)")
+	policyFlag         = flag.String("policy", "", "JSON file with allowed, denied and to be reviewed licenses")
+	splitFlag          = flag.String("split", "", "write one output per main module of the workspace to `DIR`, of the modules built into its packages")
+	stdlibFlag         = flag.Bool("stdlib", false, "add the Go distribution, whose runtime and standard library are linked into every binary")
+	goproxyFlag        = flag.String("goproxy", "", "local GOPROXY `DIR` to take modules of scanned binaries from, if not in the module cache (default: file:// entries of GOPROXY)")
+	goosFlag           = flag.String("goos", "", "regard the packages built for `GOOS`")
+	goarchFlag         = flag.String("goarch", "", "regard the packages built for `GOARCH`")
+	tagsFlag           = flag.String("tags", "", "regard the packages built with the comma separated build `TAGS`")
+	targetsFlag        = flag.String("targets", "", "regard the packages built for any of the comma separated `GOOS/GOARCH` targets, listing the targets of each module")
+	formatFlag         = flag.String("format", "text", "output format of the manifest: text, json, spdx, spdx-json, cyclonedx or cyclonedx-xml")
 )
 
 func buildPath(pkgname string) string {
@@ -108,18 +165,13 @@ func modCommand(cmd string) *exec.Cmd {
 }
 
 func readModule() []metadata {
-	var cmd *exec.Cmd
-	if *vendorFlag {
-		cmd = modCommand("go list -m -json -mod=mod all")
-	} else {
-		/* If we aren't using vendored dependencies, we need to make
-		 * sure that all dependencies are available */
-		err := modCommand("go mod download").Run()
-		if err != nil {
-			log.Fatalln(err)
-		}
-		cmd = modCommand("go list -m -json all")
+	/* If we aren't using vendored dependencies, we need to make
+	 * sure that all dependencies are available */
+	err := modCommand("go mod download").Run()
+	if err != nil {
+		log.Fatalln(err)
 	}
+	cmd := modCommand("go list -m -json all")
 	output, err := cmd.StdoutPipe()
 	if err != nil {
 		log.Fatalln(err)
@@ -137,6 +189,11 @@ func readModule() []metadata {
 		Path    string
 		Version string
 		Dir     string
+		Main    bool
+		Replace *struct {
+			Path    string
+			Version string
+		}
 	}
 
 	ret := []metadata{}
@@ -159,6 +216,10 @@ func readModule() []metadata {
 			name:    m.Path,
 			version: m.Version,
 			path:    m.Dir,
+			main:    m.Main,
+		}
+		if m.Replace != nil {
+			meta.replace = strings.TrimSpace(m.Replace.Path + " " + m.Replace.Version)
 		}
 
 		ret = append(ret, meta)
@@ -172,21 +233,70 @@ func readModule() []metadata {
 	return ret
 }
 
-func createManifest(manifest []metadata) error {
-	writer := tabwriter.NewWriter(os.Stdout, 1, 4, 2, ' ', 0)
+var manifestWriters = map[string]func(io.Writer, []metadata) error{
+	"text":          writeTextManifest,
+	"json":          writeJSONManifest,
+	"spdx":          writeSPDXTagValue,
+	"spdx-json":     writeSPDXJSON,
+	"cyclonedx":     writeCycloneDXJSON,
+	"cyclonedx-xml": writeCycloneDXXML,
+}
+
+func createManifest(w io.Writer, manifest []metadata) error {
+	write, ok := manifestWriters[*formatFlag]
+	if !ok {
+		return fmt.Errorf("unknown manifest format %q", *formatFlag)
+	}
+	return write(w, manifest)
+}
+
+func writeTextManifest(w io.Writer, manifest []metadata) error {
+	writer := tabwriter.NewWriter(w, 10, 4, 1, ' ', 0)
 
 	for k := 0; k < len(manifest); k++ {
-		pkgInfo := fmt.Sprintf("name:     %s\n", manifest[k].name)
+		pkgInfo := fmt.Sprintf("name:\t%s\n", manifest[k].name)
+		if manifest[k].main {
+			pkgInfo += "main:\ttrue\n"
+		}
 		if manifest[k].revision != "" {
-			pkgInfo += fmt.Sprintf("revision: %s\n", manifest[k].revision)
+			pkgInfo += fmt.Sprintf("revision:\t%s\n", manifest[k].revision)
 		}
 		if manifest[k].version != "" {
-			pkgInfo += fmt.Sprintf("version:  %s\n", manifest[k].version)
+			pkgInfo += fmt.Sprintf("version:\t%s\n", manifest[k].version)
 		}
 		if manifest[k].branch != "" {
-			pkgInfo += fmt.Sprintf("branch:   %s\n", manifest[k].branch)
+			pkgInfo += fmt.Sprintf("branch:\t%s\n", manifest[k].branch)
+		}
+		if manifest[k].replace != "" {
+			pkgInfo += fmt.Sprintf("replace:\t%s\n", manifest[k].replace)
+		}
+		if manifest[k].sum != "" {
+			pkgInfo += fmt.Sprintf("sum:\t%s\n", manifest[k].sum)
+		}
+		if len(manifest[k].packages) > 0 {
+			pkgInfo += fmt.Sprintf("packages:\t%s\n", strings.Join(manifest[k].packages, ", "))
+		}
+		if len(manifest[k].targets) > 0 {
+			pkgInfo += fmt.Sprintf("targets:\t%s\n", strings.Join(manifest[k].targets, ", "))
+		}
+		pkgInfo += fmt.Sprintf("license:\t%s\n", manifest[k].license)
+		if result := manifest[k].detected; result != nil {
+			if len(result.Licenses) > 1 {
+				pkgInfo += fmt.Sprintf("spdx:\t%s\n", result.Expression)
+			}
+			if len(result.Tags) > 0 && !result.Licenses[0].Tag {
+				pkgInfo += fmt.Sprintf("tags:\t%s\n", strings.Join(result.Tags, ", "))
+			}
+			for _, copyright := range result.Copyrights {
+				pkgInfo += fmt.Sprintf("copyright:\t%s\n", copyright)
+			}
+		}
+		if manifest[k].exception != nil {
+			pkgInfo += fmt.Sprintf("policy:\t%s (%s)\n",
+				manifest[k].verdict, manifest[k].exception.Justification)
+		} else {
+			pkgInfo += fmt.Sprintf("policy:\t%s\n", manifest[k].verdict)
 		}
-		pkgInfo += fmt.Sprintf("license:  %s\n", manifest[k].license)
 
 		_, err := writer.Write([]byte(pkgInfo + "\n"))
 		if err != nil {
@@ -197,42 +307,259 @@ func createManifest(manifest []metadata) error {
 	return nil
 }
 
-func identifyLicenses(manifest []metadata, ignoreCritLicsFlag bool) {
-	for k := 0; k < len(manifest); k++ {
-		if len(manifest[k].path) < 1 {
-			// skip processing empty entries as this would lead to an error
-			continue
+// reportModules returns the modules of the manifest for a licenses report.
+func reportModules(manifest []metadata) []licenses.Module {
+	modules := []licenses.Module{}
+	for _, meta := range manifest {
+		modules = append(modules, licenses.Module{
+			Name:       meta.name,
+			Version:    meta.version,
+			Revision:   meta.revision,
+			Branch:     meta.branch,
+			Path:       meta.path,
+			Main:       meta.main,
+			ResolveErr: meta.resolveErr,
+		})
+	}
+	return modules
+}
+
+// identifyLicenses identifies the licenses of all packages and assesses them
+// by the policy. The results are stored in the manifest.
+func identifyLicenses(manifest []metadata, policy *licenses.Policy) *licenses.Report {
+	detector, err := licenses.NewDetector()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if *templatesFlag != "" {
+		if err := detector.LoadTemplates(*templatesFlag); err != nil {
+			log.Fatalln(err)
 		}
-		licenseString, err := licenses.BuildLicenseString(manifest[k].path)
-		if err != nil && !ignoreCritLicsFlag {
-			fmt.Fprintln(os.Stderr, err)
-			os.Exit(1)
-		} else {
-			manifest[k].license = licenseString
+	}
+	if !*noCacheFlag {
+		cache, err := openCache()
+		if err != nil {
+			// Scanning without cache is slower, but works nonetheless
+			log.Println("Unable to open license cache:", err)
+		}
+		detector.SetCache(cache)
+		// Extracted modules are verified, so they never change either
+		if dir, err := moduleExtractDir(); err == nil {
+			detector.SetCachedDirs(licenses.ModuleCacheDir(), dir)
+		}
+	}
+	detector.SetTagCrossCheck(*spdxTagsFlag)
+	detector.SetSourceCopyrights(*srcCopyrightsFlag)
+
+	report := licenses.NewReport(reportModules(manifest))
+	if err := report.Identify(detector, policy, *jobsFlag); err != nil {
+		log.Fatalln(err)
+	}
+
+	for k, module := range report.Modules {
+		result := module.Result
+		if result != nil {
+			manifest[k].license = result.String()
+			manifest[k].detected = result
+			if mismatches := result.TagMismatches(); len(mismatches) > 0 {
+				fmt.Fprintf(os.Stderr, "SPDX-License-Identifier of %s does not match its license files: %s\n",
+					manifest[k].name, strings.Join(mismatches, ", "))
+			}
+		}
+
+		manifest[k].verdict, manifest[k].exception = module.Verdict, module.Exception
+		// Packages without identified license are as critical as denied ones
+		manifest[k].critical = module.Critical()
+		// Denied licenses are reported with the other problems
+		if manifest[k].verdict == licenses.VerdictReview {
+			nickname := "?"
+			if result != nil {
+				nickname = strings.Join(result.Nicknames(), ", ")
+			}
+			fmt.Fprintf(os.Stderr, "%s: %s (%s)\n", manifest[k].verdict, manifest[k].name, nickname)
 		}
 	}
+	return report
 }
 
-func createDisclaimer(manifest []metadata) error {
-	for k := 0; k < len(manifest); k++ {
-		err := licenses.BuildDisclaimerString(manifest[k].path, manifest[k].name)
+// problemsExitCode combines the exit codes of the categories of all
+// problems.
+func problemsExitCode(problems []error) int {
+	code := 0
+	for _, problem := range problems {
+		var noLicense *licenses.NoLicenseError
+		var unreadable *licenses.ReadError
+		var notice *licenses.NoticeError
+		var disclaimer *licenses.DisclaimerError
+		var lowConfidence *licenses.LowConfidenceError
+		var denied *licenses.DeniedError
+		var critical *licenses.CriticalLicenseError
+		switch {
+		case errors.As(problem, &noLicense), errors.Is(problem, licenses.ErrNoSource):
+			code |= exitNoLicense
+		case errors.As(problem, &unreadable), errors.As(problem, &notice), errors.As(problem, &disclaimer):
+			code |= exitUnreadable
+		case errors.As(problem, &lowConfidence):
+			code |= exitLowConfidence
+		case errors.As(problem, &denied), errors.As(problem, &critical):
+			code |= exitDenied
+		default:
+			code |= exitError
+		}
+	}
+	return code
+}
+
+// reportProblems prints all problems found with the licenses and exits with
+// their exit code, unless they are ignored.
+func reportProblems(report *licenses.Report, ignore bool) {
+	problems := report.Problems()
+	for _, problem := range problems {
+		fmt.Fprintln(os.Stderr, problem)
+	}
+	if len(problems) > 0 && !ignore {
+		os.Exit(problemsExitCode(problems))
+	}
+}
+
+// openOutput opens the file given by -o or returns stdout.
+func openOutput() *os.File {
+	if *outputFlag == "" || *outputFlag == "-" {
+		return os.Stdout
+	}
+	file, err := os.Create(*outputFlag)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	return file
+}
+
+func closeOutput(output *os.File) {
+	if output == os.Stdout {
+		return
+	}
+	if err := output.Close(); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+func openCache() (*licenses.Cache, error) {
+	dir := *cacheFlag
+	if dir == "" {
+		var err error
+		dir, err = licenses.DefaultCacheDir()
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
+	return licenses.OpenCache(dir)
+}
+
+func pruneCache() error {
+	cache, err := openCache()
+	if err != nil {
+		return err
+	}
+	removed, err := cache.Prune(cachePruneAge)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("removed %d cache entries\n", removed)
 	return nil
 }
 
+// usageError prints the message and exits with the code for invalid usage.
+func usageError(message string) {
+	log.Println(message)
+	os.Exit(exitUsage)
+}
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), "Usage:\n")
+	fmt.Fprintf(flag.CommandLine.Output(), "  %s [flags] -m|-d|-obligations|-notices [packages]\n", os.Args[0])
+	fmt.Fprintf(flag.CommandLine.Output(), "  %s [flags] -m|-d|-obligations|-notices scan-binary FILE\n", os.Args[0])
+	fmt.Fprintf(flag.CommandLine.Output(), "  %s [flags] cache prune\n", os.Args[0])
+	fmt.Fprintf(flag.CommandLine.Output(), "Given packages or a target, only the modules built into the packages, by\n")
+	fmt.Fprintf(flag.CommandLine.Output(), "default the main packages of the main modules, are regarded. This needs the\n")
+	fmt.Fprintf(flag.CommandLine.Output(), "go command.\n")
+	flag.PrintDefaults()
+	fmt.Fprintf(flag.CommandLine.Output(), "Exit codes, combined (bitwise OR) for several problems:\n")
+	fmt.Fprintf(flag.CommandLine.Output(), "  %2d  failure\n", exitError)
+	fmt.Fprintf(flag.CommandLine.Output(), "  %2d  invalid usage\n", exitUsage)
+	fmt.Fprintf(flag.CommandLine.Output(), "  %2d  missing license file or module sources\n", exitNoLicense)
+	fmt.Fprintf(flag.CommandLine.Output(), "  %2d  unreadable license or NOTICE file\n", exitUnreadable)
+	fmt.Fprintf(flag.CommandLine.Output(), "  %2d  license not identified without doubt\n", exitLowConfidence)
+	fmt.Fprintf(flag.CommandLine.Output(), "  %2d  license denied by the policy\n", exitDenied)
+}
+
 func main() {
 	if runtime.GOOS != "linux" {
 		log.Fatalf("Error: This tool is running in linux only!")
 	}
 
+	flag.Usage = usage
 	flag.Parse()
-	if *manifestFlag == *disclaimerFlag {
-		fmt.Fprintf(flag.CommandLine.Output(), "Usage of %s:\n", os.Args[0])
-		flag.PrintDefaults()
-		os.Exit(1)
+
+	binary := ""
+	if flag.Arg(0) == "scan-binary" {
+		if flag.NArg() != 2 {
+			usage()
+			os.Exit(exitUsage)
+		}
+		binary = flag.Arg(1)
+	} else if flag.Arg(0) == "cache" {
+		if flag.NArg() != 2 || flag.Arg(1) != "prune" {
+			usage()
+			os.Exit(exitUsage)
+		}
+		if err := pruneCache(); err != nil {
+			log.Fatalln(err)
+		}
+		return
+	}
+
+	modes := 0
+	for _, mode := range []bool{*manifestFlag, *disclaimerFlag, *obligationsFlag, *noticesFlag} {
+		if mode {
+			modes++
+		}
+	}
+	_, validFormat := manifestWriters[*formatFlag]
+	if *obligationsFlag {
+		_, validFormat = obligationsWriters[*formatFlag]
+	} else if *noticesFlag {
+		validFormat = *formatFlag == "text"
+	}
+	targets := []target{{goos: *goosFlag, goarch: *goarchFlag}}
+	if *targetsFlag != "" {
+		var err error
+		targets, err = parseTargets(*targetsFlag)
+		if err != nil || *goosFlag != "" || *goarchFlag != "" {
+			usage()
+			os.Exit(exitUsage)
+		}
+	}
+	if modes != 1 || !validFormat {
+		usage()
+		os.Exit(exitUsage)
+	}
+
+	policy := licenses.DefaultPolicy()
+	if *policyFlag != "" {
+		var err error
+		policy, err = licenses.LoadPolicy(*policyFlag)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		// Expired exceptions fail even unused, so approvals get reviewed
+		expired := policy.ExpiredExceptions(time.Now())
+		for _, exception := range expired {
+			fmt.Fprintf(os.Stderr, "expired exception: %s %s (expired %s)\n",
+				exception.Module, exception.Versions, exception.Expires)
+		}
+		if len(expired) > 0 {
+			os.Exit(exitError)
+		}
 	}
 
 	_, err := os.Stat(gopkgFile)
@@ -241,26 +568,94 @@ func main() {
 	}
 
 	var manifest []metadata
-
-	if err == nil {
+	// withoutGo is set if the modules are read without the go command, which
+	// is needed to resolve the packages
+	withoutGo := false
+	// goVersion is the Go release a scanned binary is built with
+	goVersion := ""
+
+	if binary != "" {
+		manifest, goVersion, err = readBinaryModules(binary)
+		if err != nil {
+			log.Fatalln(err)
+		}
+	} else if err == nil {
 		manifest = readGopkgFile()
+	} else if *vendorFlag {
+		manifest, err = readVendorModules()
+		if err != nil {
+			log.Fatalln(err)
+		}
+	} else if _, lookErr := exec.LookPath("go"); *noGoFlag || lookErr != nil {
+		withoutGo = true
+		manifest, err = readGoMod()
+		if err != nil {
+			log.Fatalln(err)
+		}
 	} else {
 		manifest = readModule()
 	}
-
-	if *manifestFlag {
-		identifyLicenses(manifest, *ignoreCritLicsFlag)
-		err := createManifest(manifest)
+	if binary != "" {
+		if *goosFlag != "" || *goarchFlag != "" || *tagsFlag != "" || *targetsFlag != "" {
+			usageError("Targets cannot be given for binaries")
+		}
+	} else if flag.NArg() > 0 || *goosFlag != "" || *goarchFlag != "" || *tagsFlag != "" || *targetsFlag != "" {
+		if _, err := os.Stat(gopkgFile); err == nil {
+			usageError("Packages cannot be given for dep projects")
+		}
+		if withoutGo {
+			usageError("Packages cannot be given without the go command")
+		}
+		manifest, err = filterPackageModules(manifest, flag.Args(), targets, *tagsFlag)
 		if err != nil {
 			log.Fatalln(err)
 		}
-	} else if *disclaimerFlag {
-		err := createDisclaimer(manifest)
+	}
+	if *stdlibFlag {
+		dist, err := goDistribution(goVersion)
 		if err != nil {
 			log.Fatalln(err)
 		}
+		manifest = append(manifest, dist)
+	}
+
+	var report *licenses.Report
+	if *disclaimerFlag {
+		report = licenses.NewReport(reportModules(manifest))
+		report.ReadDisclaimers()
+	} else {
+		report = identifyLicenses(manifest, policy)
+	}
+	if *splitFlag != "" {
+		err = writeMembers(*splitFlag, manifest, report, targets)
 	} else {
-		// Should not be reached
-		panic("invalid flag combination")
+		output := openOutput()
+		err = writeOutput(output, manifest, report)
+		closeOutput(output)
+	}
+	if err != nil {
+		log.Fatalln(err)
+	}
+	reportProblems(report, *ignoreCritLicsFlag)
+}
+
+// writeOutput writes the output of the selected mode for the manifest and
+// its report.
+func writeOutput(w io.Writer, manifest []metadata, report *licenses.Report) error {
+	switch {
+	case *manifestFlag:
+		return createManifest(w, manifest)
+	case *obligationsFlag:
+		return createObligations(w, report.Obligations())
+	case *noticesFlag:
+		notices, err := report.Notices()
+		if err != nil {
+			return err
+		}
+		return licenses.WriteNotices(w, notices)
+	case *disclaimerFlag:
+		return report.WriteDisclaimers(w)
 	}
+	// Should not be reached
+	panic("invalid flag combination")
 }
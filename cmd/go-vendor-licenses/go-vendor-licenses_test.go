@@ -0,0 +1,80 @@
+/*
+ * go-vendor-licenses - go-vendor-licenses_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	licenses "github.com/tq-systems/go-vendor-licenses/licenses"
+)
+
+func TestProblemsExitCode(t *testing.T) {
+	err := errors.New("permission denied")
+	tests := []struct {
+		name     string
+		problems []error
+		code     int
+	}{
+		{"none", nil, 0},
+		{"unreadable disclaimer", []error{&licenses.DisclaimerError{Module: "m", Err: err}}, exitUnreadable},
+		{"unreadable notice", []error{&licenses.NoticeError{Module: "m", Err: err}}, exitUnreadable},
+		{"no sources", []error{&licenses.ReadError{Path: "m", Err: licenses.ErrNoSource}}, exitNoLicense},
+		{"combined", []error{
+			&licenses.DisclaimerError{Module: "m", Err: err},
+			&licenses.DeniedError{Module: "n"},
+		}, exitUnreadable | exitDenied},
+		{"other", []error{err}, exitError},
+	}
+	for _, test := range tests {
+		if code := problemsExitCode(test.problems); code != test.code {
+			t.Errorf("%s: exit code %d, want %d", test.name, code, test.code)
+		}
+	}
+}
+
+func TestWriteTextManifestAlignsKeys(t *testing.T) {
+	manifest := []metadata{
+		{name: "example.com/a", version: "v1.0.0", license: "MIT", verdict: licenses.VerdictAllow},
+		{name: "example.com/b", version: "v1.2.0", license: "MIT", verdict: licenses.VerdictAllow, detected: &licenses.Result{
+			Licenses:   []*licenses.License{{Score: 1}},
+			Expression: "MIT",
+			Copyrights: []licenses.Copyright{{Years: "2016", Holder: "Google Inc."}},
+		}},
+	}
+	buffer := &bytes.Buffer{}
+	if err := writeTextManifest(buffer, manifest); err != nil {
+		t.Fatal(err)
+	}
+	want := `name:     example.com/a
+version:  v1.0.0
+license:  MIT
+policy:   allow
+
+name:      example.com/b
+version:   v1.2.0
+license:   MIT
+copyright: Copyright (c) 2016 Google Inc.
+policy:    allow
+
+`
+	if buffer.String() != want {
+		t.Errorf("manifest\n%s\nwant\n%s", buffer, want)
+	}
+}
+
+func TestExitCodesAreDistinctBits(t *testing.T) {
+	combined := 0
+	for _, code := range []int{exitError, exitUsage, exitNoLicense, exitUnreadable, exitLowConfidence, exitDenied} {
+		if code&(code-1) != 0 || combined&code != 0 {
+			t.Errorf("exit code %d is no distinct bit", code)
+		}
+		combined |= code
+	}
+}
@@ -0,0 +1,474 @@
+/*
+ * go-vendor-licenses - gomod.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	licenses "github.com/tq-systems/go-vendor-licenses/licenses"
+)
+
+// errUnresolved is the error of modules whose requirements are unknown.
+var errUnresolved = errors.New("go.mod not found in the module cache, selected versions may be too low")
+
+// goModFile holds the directives of a go.mod or go.work file relevant for
+// resolving the dependencies.
+type goModFile struct {
+	// dir is the directory of the file
+	dir       string
+	module    string
+	goVersion string
+	require   []modVersion
+	exclude   map[modVersion]bool
+	replace   []modReplace
+	// use are the directories of the modules of a workspace
+	use []string
+}
+
+type modVersion struct {
+	path    string
+	version string
+}
+
+// modReplace replaces a module, or only one version of it, by another
+// module or a directory, which has no version. Directories are relative to
+// dir, the one of the file with the replacement.
+type modReplace struct {
+	old modVersion
+	new modVersion
+	dir string
+}
+
+func parseGoModFile(file string) (*goModFile, error) {
+	mod, err := parseModFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if mod.module == "" {
+		return nil, fmt.Errorf("%s: no module directive found", file)
+	}
+	return mod, nil
+}
+
+func parseModFile(file string) (*goModFile, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseGoMod(file, f)
+}
+
+// parseGoMod parses a go.mod or go.work file.
+func parseGoMod(name string, r io.Reader) (*goModFile, error) {
+	mod := goModFile{dir: filepath.Dir(name), exclude: map[modVersion]bool{}}
+	block := ""
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.Index(text, "//"); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		verb := block
+		if block == "" {
+			verb = fields[0]
+			fields = fields[1:]
+			if len(fields) == 1 && fields[0] == "(" {
+				block = verb
+				continue
+			}
+		} else if fields[0] == ")" {
+			block = ""
+			continue
+		}
+		for k := range fields {
+			if unquoted, err := strconv.Unquote(fields[k]); err == nil {
+				fields[k] = unquoted
+			}
+		}
+
+		invalid := fmt.Errorf("%s:%d: invalid %s directive", name, line, verb)
+		switch verb {
+		case "module":
+			if len(fields) != 1 {
+				return nil, invalid
+			}
+			mod.module = fields[0]
+		case "go":
+			if len(fields) != 1 {
+				return nil, invalid
+			}
+			mod.goVersion = fields[0]
+		case "require":
+			if len(fields) != 2 {
+				return nil, invalid
+			}
+			mod.require = append(mod.require, modVersion{fields[0], fields[1]})
+		case "exclude":
+			if len(fields) != 2 {
+				return nil, invalid
+			}
+			mod.exclude[modVersion{fields[0], fields[1]}] = true
+		case "replace":
+			// old [version] => new [version]
+			arrow := -1
+			for k, field := range fields {
+				if field == "=>" {
+					arrow = k
+				}
+			}
+			if arrow < 1 || arrow > 2 || len(fields)-arrow < 2 || len(fields)-arrow > 3 {
+				return nil, invalid
+			}
+			replace := modReplace{
+				old: modVersion{path: fields[0]},
+				new: modVersion{path: fields[arrow+1]},
+				dir: mod.dir,
+			}
+			if arrow == 2 {
+				replace.old.version = fields[1]
+			}
+			if len(fields)-arrow == 3 {
+				replace.new.version = fields[arrow+2]
+			}
+			mod.replace = append(mod.replace, replace)
+		case "use":
+			if len(fields) != 1 {
+				return nil, invalid
+			}
+			mod.use = append(mod.use, fields[0])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return &mod, nil
+}
+
+// hasPrunedGraph reports whether go.mod lists all modules needed to build
+// the main module, which is the case since Go 1.17.
+func (mod *goModFile) hasPrunedGraph() bool {
+	parts := strings.SplitN(mod.goVersion, ".", 3)
+	if len(parts) < 2 {
+		return false
+	}
+	major, err1 := strconv.Atoi(parts[0])
+	minor, err2 := strconv.Atoi(parts[1])
+	return err1 == nil && err2 == nil && (major > 1 || minor >= 17)
+}
+
+// findGoWork returns the go.work file of the workspace the current
+// directory belongs to, like the go command does, or an empty string.
+func findGoWork(cwd string) string {
+	switch gowork := os.Getenv("GOWORK"); gowork {
+	case "off":
+		return ""
+	case "":
+	default:
+		return gowork
+	}
+	for dir := cwd; ; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, "go.work")); err == nil {
+			return filepath.Join(dir, "go.work")
+		}
+		if filepath.Dir(dir) == dir {
+			return ""
+		}
+	}
+}
+
+// escapeModPath escapes upper case letters as the module cache does, as
+// file systems may not be case sensitive: "!" followed by the lower case
+// letter.
+func escapeModPath(path string) string {
+	escaped := strings.Builder{}
+	for _, r := range path {
+		if r >= 'A' && r <= 'Z' {
+			escaped.WriteByte('!')
+			r += 'a' - 'A'
+		}
+		escaped.WriteRune(r)
+	}
+	return escaped.String()
+}
+
+// modCachePath returns the directory of the extracted module in the cache.
+func modCachePath(cache string, m modVersion) string {
+	return filepath.Join(cache, filepath.FromSlash(escapeModPath(m.path)+"@"+escapeModPath(m.version)))
+}
+
+// goModResolver resolves the build list of the main modules from go.mod
+// files and the module cache only. There are several main modules in a
+// workspace.
+type goModResolver struct {
+	cache string
+	mains []*goModFile
+	// replace are the replacements of go.work followed by the ones of the
+	// main modules, the first one applies
+	replace []modReplace
+	// unresolved are the modules whose requirements are unknown, as their
+	// go.mod is missing. Lower versions may be selected without them.
+	unresolved []modVersion
+}
+
+func newGoModResolver(cache string, work *goModFile, mains []*goModFile) *goModResolver {
+	resolver := goModResolver{cache: cache, mains: mains}
+	if work != nil {
+		resolver.replace = append(resolver.replace, work.replace...)
+	}
+	for _, mod := range mains {
+		resolver.replace = append(resolver.replace, mod.replace...)
+	}
+	return &resolver
+}
+
+// isMain reports whether the module is a main module.
+func (resolver *goModResolver) isMain(path string) bool {
+	for _, mod := range resolver.mains {
+		if mod.module == path {
+			return true
+		}
+	}
+	return false
+}
+
+// excluded reports whether a main module excludes the module version.
+func (resolver *goModResolver) excluded(m modVersion) bool {
+	for _, mod := range resolver.mains {
+		if mod.exclude[m] {
+			return true
+		}
+	}
+	return false
+}
+
+// replacement returns the replacement of the module version, if any.
+// Replacements of a single version take precedence.
+func (resolver *goModResolver) replacement(m modVersion) (modReplace, bool) {
+	found := false
+	var ret modReplace
+	for _, replace := range resolver.replace {
+		if replace.old.path != m.path {
+			continue
+		}
+		if replace.old.version == m.version {
+			return replace, true
+		}
+		if replace.old.version == "" && !found {
+			ret, found = replace, true
+		}
+	}
+	return ret, found
+}
+
+// source returns the module the sources of m are taken from and their
+// directory.
+func (resolver *goModResolver) source(m modVersion) (modVersion, string) {
+	replace, ok := resolver.replacement(m)
+	if !ok {
+		return m, modCachePath(resolver.cache, m)
+	}
+	if replace.new.version == "" {
+		dir := filepath.FromSlash(replace.new.path)
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(replace.dir, dir)
+		}
+		return replace.new, dir
+	}
+	return replace.new, modCachePath(resolver.cache, replace.new)
+}
+
+// requirements reads the requirements of a dependency from the go.mod of
+// its sources or the module cache. Without go.mod, the module is recorded as
+// unresolved.
+func (resolver *goModResolver) requirements(m modVersion) []modVersion {
+	source, dir := resolver.source(m)
+	files := []string{filepath.Join(dir, "go.mod")}
+	if source.version != "" {
+		files = append(files, filepath.Join(resolver.cache, "cache", "download",
+			filepath.FromSlash(escapeModPath(source.path)), "@v", escapeModPath(source.version)+".mod"))
+	}
+	for _, file := range files {
+		mod, err := parseGoModFile(file)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			// Old modules may have no or only a partial go.mod
+			return nil
+		}
+		return mod.require
+	}
+	resolver.unresolved = append(resolver.unresolved, m)
+	return nil
+}
+
+// buildList selects the version of every module required by the main
+// modules. Since Go 1.17, go.mod lists them all. Before, the requirements of
+// all required versions have to be walked, selecting the highest version of
+// each module (MVS).
+func (resolver *goModResolver) buildList() []modVersion {
+	selected := map[string]string{}
+	queue := []modVersion{}
+	add := func(m modVersion) {
+		if resolver.excluded(m) || resolver.isMain(m.path) {
+			return
+		}
+		if v, ok := selected[m.path]; !ok || licenses.CompareVersions(v, m.version) < 0 {
+			selected[m.path] = m.version
+		}
+		// The requirements of versions not selected count as well
+		queue = append(queue, m)
+	}
+
+	pruned := true
+	for _, mod := range resolver.mains {
+		for _, m := range mod.require {
+			add(m)
+		}
+		pruned = pruned && mod.hasPrunedGraph()
+	}
+	if !pruned {
+		visited := map[modVersion]bool{}
+		for len(queue) > 0 {
+			m := queue[0]
+			queue = queue[1:]
+			if visited[m] {
+				continue
+			}
+			visited[m] = true
+			for _, r := range resolver.requirements(m) {
+				add(r)
+			}
+		}
+	}
+
+	list := []modVersion{}
+	for path, version := range selected {
+		list = append(list, modVersion{path, version})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].path < list[j].path
+	})
+	return list
+}
+
+// parseGoSum parses the checksums of the module trees in a go.sum or
+// go.work.sum file, which may be missing.
+func parseGoSum(file string, sums map[modVersion]string) error {
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 {
+			return fmt.Errorf("%s:%d: invalid checksum line", file, line)
+		}
+		// The checksums of the go.mod files alone are not regarded
+		if !strings.HasSuffix(fields[1], "/go.mod") {
+			sums[modVersion{fields[0], fields[1]}] = fields[2]
+		}
+	}
+	return scanner.Err()
+}
+
+// readGoMod reads the modules of the main module in the current directory,
+// or of all modules of its workspace, from go.mod and go.work files and the
+// module cache, without the go command. Modules not in the cache are kept
+// without path, so identifying their licenses fails. Modules whose
+// requirements are unknown get a ReadError, as the build list may be wrong.
+func readGoMod() ([]metadata, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	var work *goModFile
+	mainDirs := []string{cwd}
+	if file := findGoWork(cwd); file != "" {
+		work, err = parseModFile(file)
+		if err != nil {
+			return nil, err
+		}
+		mainDirs = []string{}
+		for _, use := range work.use {
+			dir := filepath.FromSlash(use)
+			if !filepath.IsAbs(dir) {
+				dir = filepath.Join(work.dir, dir)
+			}
+			mainDirs = append(mainDirs, dir)
+		}
+	}
+
+	mains := []*goModFile{}
+	sums := map[modVersion]string{}
+	ret := []metadata{}
+	for _, dir := range mainDirs {
+		mod, err := parseGoModFile(filepath.Join(dir, "go.mod"))
+		if err != nil {
+			return nil, err
+		}
+		if err := parseGoSum(filepath.Join(dir, "go.sum"), sums); err != nil {
+			return nil, err
+		}
+		mains = append(mains, mod)
+		ret = append(ret, metadata{name: mod.module, path: dir, main: true})
+	}
+	if work != nil {
+		if err := parseGoSum(filepath.Join(work.dir, "go.work.sum"), sums); err != nil {
+			return nil, err
+		}
+	}
+	resolver := newGoModResolver(licenses.ModuleCacheDir(), work, mains)
+
+	for _, m := range resolver.buildList() {
+		source, dir := resolver.source(m)
+		meta := metadata{
+			name:    m.path,
+			version: m.version,
+			sum:     sums[source],
+		}
+		if source != m {
+			meta.replace = strings.TrimSpace(source.path + " " + source.version)
+		}
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			meta.path = dir
+		}
+		for _, unresolved := range resolver.unresolved {
+			if unresolved.path == m.path {
+				meta.resolveErr = &licenses.ReadError{
+					Path: unresolved.path + "@" + unresolved.version,
+					Err:  errUnresolved,
+				}
+			}
+		}
+		ret = append(ret, meta)
+	}
+	return ret, nil
+}
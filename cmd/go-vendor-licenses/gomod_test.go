@@ -0,0 +1,291 @@
+/*
+ * go-vendor-licenses - gomod_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tq-systems/go-vendor-licenses/licenses"
+)
+
+func TestParseGoMod(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    *goModFile
+	}{
+		{"directives", `module example.com/m // the module
+
+go 1.21
+
+require example.com/a v1.0.0
+require (
+	example.com/b v1.1.0 // indirect
+	"example.com/c" v1.2.0
+)
+exclude example.com/b v1.0.0
+replace example.com/a => example.com/fork v1.0.1
+replace (
+	example.com/b v1.1.0 => ../b
+)
+`, &goModFile{
+			dir:       "/src/m",
+			module:    "example.com/m",
+			goVersion: "1.21",
+			require:   []modVersion{{"example.com/a", "v1.0.0"}, {"example.com/b", "v1.1.0"}, {"example.com/c", "v1.2.0"}},
+			exclude:   map[modVersion]bool{{"example.com/b", "v1.0.0"}: true},
+			replace: []modReplace{
+				{old: modVersion{"example.com/a", ""}, new: modVersion{"example.com/fork", "v1.0.1"}, dir: "/src/m"},
+				{old: modVersion{"example.com/b", "v1.1.0"}, new: modVersion{"../b", ""}, dir: "/src/m"},
+			},
+		}},
+		{"workspace", "go 1.21\n\nuse (\n\t./a\n\t./b\n)\n", &goModFile{
+			dir:       "/src/m",
+			goVersion: "1.21",
+			exclude:   map[modVersion]bool{},
+			use:       []string{"./a", "./b"},
+		}},
+		{"invalid require", "module example.com/m\nrequire example.com/a\n", nil},
+		{"invalid replace", "module example.com/m\nreplace example.com/a v1.0.0\n", nil},
+		{"invalid module", "module\n", nil},
+	}
+	for _, test := range tests {
+		mod, err := parseGoMod("/src/m/go.mod", strings.NewReader(test.content))
+		if test.want == nil {
+			if err == nil {
+				t.Errorf("%s: no error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+		} else if !reflect.DeepEqual(mod, test.want) {
+			t.Errorf("%s: parsed %+v, want %+v", test.name, mod, test.want)
+		}
+	}
+}
+
+func TestHasPrunedGraph(t *testing.T) {
+	tests := []struct {
+		goVersion string
+		pruned    bool
+	}{
+		{"", false},
+		{"1.16", false},
+		{"1.17", true},
+		{"1.21.3", true},
+		{"2.0", true},
+	}
+	for _, test := range tests {
+		mod := goModFile{goVersion: test.goVersion}
+		if pruned := mod.hasPrunedGraph(); pruned != test.pruned {
+			t.Errorf("go %s: pruned %v, want %v", test.goVersion, pruned, test.pruned)
+		}
+	}
+}
+
+func TestEscapeModPath(t *testing.T) {
+	if escaped := escapeModPath("github.com/BurntSushi/toml"); escaped != "github.com/!burnt!sushi/toml" {
+		t.Errorf("escaped %s", escaped)
+	}
+}
+
+// writeFile creates the file with the content and its directory.
+func writeFile(t *testing.T, file string, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// goMod returns a go.mod requiring the modules, given as "path version".
+func goMod(module string, requires ...string) string {
+	content := "module " + module + "\n\ngo 1.16\n"
+	for _, require := range requires {
+		content += "require " + require + "\n"
+	}
+	return content
+}
+
+func TestBuildList(t *testing.T) {
+	// The module cache has the go.mod files either in the extracted module
+	// or only in the download cache
+	cache := t.TempDir()
+	extracted := func(m modVersion, requires ...string) {
+		writeFile(t, filepath.Join(modCachePath(cache, m), "go.mod"), goMod(m.path, requires...))
+	}
+	downloaded := func(m modVersion, requires ...string) {
+		writeFile(t, filepath.Join(cache, "cache", "download", escapeModPath(m.path), "@v", m.version+".mod"),
+			goMod(m.path, requires...))
+	}
+	extracted(modVersion{"example.com/a", "v1.0.0"}, "example.com/b v1.1.0", "example.com/c v1.0.0")
+	extracted(modVersion{"example.com/b", "v1.0.0"}, "example.com/d v1.0.0")
+	downloaded(modVersion{"example.com/b", "v1.1.0"})
+	downloaded(modVersion{"example.com/b", "v1.2.0"})
+	extracted(modVersion{"example.com/c", "v1.0.0"}, "example.com/b v1.2.0")
+	extracted(modVersion{"example.com/Fork", "v1.0.0"})
+	downloaded(modVersion{"example.com/d", "v1.0.0"})
+	// The local replacement requires e, whose go.mod is missing
+	local := t.TempDir()
+	writeFile(t, filepath.Join(local, "c", "go.mod"), goMod("example.com/c", "example.com/e v1.0.0"))
+
+	tests := []struct {
+		name       string
+		gomod      string
+		want       []string
+		unresolved []modVersion
+	}{
+		{"minimal version selection", goMod("example.com/m", "example.com/a v1.0.0", "example.com/b v1.0.0"),
+			[]string{"example.com/a v1.0.0", "example.com/b v1.2.0", "example.com/c v1.0.0", "example.com/d v1.0.0"}, nil},
+		{"pruned graph", strings.Replace(goMod("example.com/m", "example.com/a v1.0.0", "example.com/b v1.0.0"), "go 1.16", "go 1.17", 1),
+			[]string{"example.com/a v1.0.0", "example.com/b v1.0.0"}, nil},
+		{"exclude", goMod("example.com/m", "example.com/a v1.0.0") + "exclude example.com/b v1.2.0\n",
+			[]string{"example.com/a v1.0.0", "example.com/b v1.1.0", "example.com/c v1.0.0"}, nil},
+		{"replace by module", goMod("example.com/m", "example.com/a v1.0.0") + "replace example.com/c => example.com/Fork v1.0.0\n",
+			[]string{"example.com/a v1.0.0", "example.com/b v1.1.0", "example.com/c v1.0.0"}, nil},
+		{"replace by directory", goMod("example.com/m", "example.com/a v1.0.0") + "replace example.com/c v1.0.0 => " + local + "/c\n",
+			[]string{"example.com/a v1.0.0", "example.com/b v1.1.0", "example.com/c v1.0.0", "example.com/e v1.0.0"},
+			[]modVersion{{"example.com/e", "v1.0.0"}}},
+	}
+	for _, test := range tests {
+		mod, err := parseGoMod("/src/m/go.mod", strings.NewReader(test.gomod))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resolver := newGoModResolver(cache, nil, []*goModFile{mod})
+
+		list := []string{}
+		for _, m := range resolver.buildList() {
+			list = append(list, m.path+" "+m.version)
+		}
+		if !reflect.DeepEqual(list, test.want) {
+			t.Errorf("%s: build list %q, want %q", test.name, list, test.want)
+		}
+		if !reflect.DeepEqual(resolver.unresolved, test.unresolved) {
+			t.Errorf("%s: unresolved %v, want %v", test.name, resolver.unresolved, test.unresolved)
+		}
+	}
+}
+
+func TestResolverSource(t *testing.T) {
+	work, err := parseGoMod("/ws/go.work", strings.NewReader("go 1.21\nreplace example.com/a => ./a-fork\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := parseGoMod("/ws/m/go.mod", strings.NewReader("module example.com/m\n"+
+		"replace example.com/a => ../a\n"+
+		"replace example.com/b => example.com/b-fork v1.0.1\n"+
+		"replace example.com/b v1.0.0 => /abs/b\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := newGoModResolver("/cache", work, []*goModFile{mod})
+
+	tests := []struct {
+		m      modVersion
+		source modVersion
+		dir    string
+	}{
+		// go.work replacements take precedence
+		{modVersion{"example.com/a", "v1.0.0"}, modVersion{"./a-fork", ""}, "/ws/a-fork"},
+		{modVersion{"example.com/b", "v1.1.0"}, modVersion{"example.com/b-fork", "v1.0.1"}, "/cache/example.com/b-fork@v1.0.1"},
+		// Replacements of a single version take precedence
+		{modVersion{"example.com/b", "v1.0.0"}, modVersion{"/abs/b", ""}, "/abs/b"},
+		{modVersion{"example.com/C", "v1.0.0"}, modVersion{"example.com/C", "v1.0.0"}, "/cache/example.com/!c@v1.0.0"},
+	}
+	for _, test := range tests {
+		source, dir := resolver.source(test.m)
+		if source != test.source || dir != filepath.FromSlash(test.dir) {
+			t.Errorf("source of %v = %v, %s, want %v, %s", test.m, source, dir, test.source, test.dir)
+		}
+	}
+}
+
+func TestParseGoSum(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "go.sum")
+	writeFile(t, file, "github.com/google/uuid v1.6.0 h1:NIvaJDMOsjHA8n1jAhLSgzrAzy1Hgr+hNrb57e+94F0=\n"+
+		"github.com/google/uuid v1.6.0/go.mod h1:TIyPZe4MgqvfeYDBFedMoGGpEw/LqOeaOT+nhxU+yHo=\n"+
+		"github.com/pkg/errors v0.9.1/go.mod h1:bwawxfHBFNV+L2hUp1rHADufV3IMtnDRdf1r5NINEl0=\n")
+
+	sums := map[modVersion]string{}
+	if err := parseGoSum(file, sums); err != nil {
+		t.Fatal(err)
+	}
+	want := map[modVersion]string{
+		{"github.com/google/uuid", "v1.6.0"}: "h1:NIvaJDMOsjHA8n1jAhLSgzrAzy1Hgr+hNrb57e+94F0=",
+	}
+	if !reflect.DeepEqual(sums, want) {
+		t.Errorf("sums %v, want %v", sums, want)
+	}
+
+	if err := parseGoSum(filepath.Join(t.TempDir(), "go.sum"), sums); err != nil {
+		t.Errorf("missing go.sum: %s", err)
+	}
+	writeFile(t, file, "github.com/google/uuid v1.6.0\n")
+	if err := parseGoSum(file, sums); err == nil {
+		t.Errorf("invalid go.sum parsed")
+	}
+}
+
+func TestReadGoModKeepsMissingModules(t *testing.T) {
+	cache := t.TempDir()
+	os.Setenv("GOMODCACHE", cache)
+	defer os.Unsetenv("GOMODCACHE")
+	// a is in the cache and requires e, which is not, like b. The
+	// requirements of both are unknown.
+	a := modVersion{"example.com/a", "v1.0.0"}
+	writeFile(t, filepath.Join(modCachePath(cache, a), "go.mod"), goMod(a.path, "example.com/e v1.0.0"))
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "go.mod"), goMod("example.com/m", "example.com/a v1.0.0", "example.com/b v1.0.0"))
+	chdir(t, dir)
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, err := readGoMod()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []metadata{
+		{name: "example.com/m", path: cwd, main: true},
+		{name: "example.com/a", version: "v1.0.0", path: modCachePath(cache, a)},
+		{name: "example.com/b", version: "v1.0.0", resolveErr: &licenses.ReadError{
+			Path: "example.com/b@v1.0.0",
+			Err:  errUnresolved,
+		}},
+		{name: "example.com/e", version: "v1.0.0", resolveErr: &licenses.ReadError{
+			Path: "example.com/e@v1.0.0",
+			Err:  errUnresolved,
+		}},
+	}
+	if !reflect.DeepEqual(manifest, want) {
+		t.Errorf("manifest %+v, want %+v", manifest, want)
+	}
+
+	// The modules without sources and requirements fail the run
+	report := licenses.NewReport(reportModules(manifest))
+	detector, err := licenses.NewDetector()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := report.Identify(detector, nil, 1); err != nil {
+		t.Fatal(err)
+	}
+	if code := problemsExitCode(report.Problems()); code&exitNoLicense == 0 || code&exitUnreadable == 0 {
+		t.Errorf("exit code %d, want missing and unreadable", code)
+	}
+}
@@ -0,0 +1,116 @@
+/*
+ * go-vendor-licenses - json.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+
+	licenses "github.com/tq-systems/go-vendor-licenses/licenses"
+)
+
+// jsonFormatVersion is incremented whenever the structure of the JSON
+// manifest changes in an incompatible way.
+const jsonFormatVersion = 1
+
+type jsonManifest struct {
+	FormatVersion int          `json:"formatVersion"`
+	Generator     string       `json:"generator"`
+	Modules       []jsonModule `json:"modules"`
+}
+
+type jsonModule struct {
+	Name       string               `json:"name"`
+	Version    string               `json:"version,omitempty"`
+	Revision   string               `json:"revision,omitempty"`
+	Branch     string               `json:"branch,omitempty"`
+	Path       string               `json:"path"`
+	Main       bool                 `json:"main,omitempty"`
+	Replace    string               `json:"replace,omitempty"`
+	Explicit   bool                 `json:"explicit,omitempty"`
+	Sum        string               `json:"sum,omitempty"`
+	Packages   []string             `json:"packages,omitempty"`
+	Targets    []string             `json:"targets,omitempty"`
+	License    *jsonLicense         `json:"license"`
+	Licenses   []jsonLicense        `json:"licenses"`
+	Expression string               `json:"expression"`
+	Tags       []string             `json:"tags"`
+	Copyrights []licenses.Copyright `json:"copyrights"`
+	Verdict    licenses.Verdict     `json:"verdict"`
+	Exception  *licenses.Exception  `json:"exception,omitempty"`
+	Critical   bool                 `json:"critical"`
+}
+
+type jsonLicense struct {
+	Nickname     string   `json:"nickname"`
+	Title        string   `json:"title"`
+	Score        float64  `json:"score"`
+	File         string   `json:"file"`
+	ExtraWords   []string `json:"extraWords"`
+	MissingWords []string `json:"missingWords"`
+	Tag          bool     `json:"tag"`
+}
+
+func writeJSONManifest(w io.Writer, manifest []metadata) error {
+	doc := jsonManifest{
+		FormatVersion: jsonFormatVersion,
+		Generator:     "go-vendor-licenses " + version,
+		Modules:       []jsonModule{},
+	}
+
+	for _, meta := range manifest {
+		module := jsonModule{
+			Name:      meta.name,
+			Version:   meta.version,
+			Revision:  meta.revision,
+			Branch:    meta.branch,
+			Path:      meta.path,
+			Main:      meta.main,
+			Replace:   meta.replace,
+			Explicit:  meta.explicit,
+			Sum:       meta.sum,
+			Packages:  meta.packages,
+			Targets:   meta.targets,
+			Verdict:   meta.verdict,
+			Exception: meta.exception,
+			Critical:  meta.critical,
+		}
+		module.Licenses = []jsonLicense{}
+		if result := meta.detected; result != nil {
+			for _, license := range result.Licenses {
+				module.Licenses = append(module.Licenses, jsonLicense{
+					Nickname:     license.Template.Nickname,
+					Title:        license.Template.Title,
+					Score:        license.Score,
+					File:         license.Path,
+					ExtraWords:   license.ExtraWords,
+					MissingWords: license.MissingWords,
+					Tag:          license.Tag,
+				})
+			}
+			module.Expression = result.Expression
+			module.Tags = result.Tags
+			module.Copyrights = result.Copyrights
+		}
+		if module.Tags == nil {
+			module.Tags = []string{}
+		}
+		if module.Copyrights == nil {
+			module.Copyrights = []licenses.Copyright{}
+		}
+		if len(module.Licenses) > 0 {
+			module.License = &module.Licenses[0]
+		}
+		doc.Modules = append(doc.Modules, module)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(doc)
+}
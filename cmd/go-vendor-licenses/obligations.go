@@ -0,0 +1,85 @@
+/*
+ * go-vendor-licenses - obligations.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	licenses "github.com/tq-systems/go-vendor-licenses/licenses"
+)
+
+var obligationsWriters = map[string]func(io.Writer, []licenses.Obligation) error{
+	"text": writeTextObligations,
+	"json": writeJSONObligations,
+}
+
+type jsonObligations struct {
+	FormatVersion int              `json:"formatVersion"`
+	Generator     string           `json:"generator"`
+	Obligations   []jsonObligation `json:"obligations"`
+}
+
+type jsonObligation struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Modules     []string `json:"modules"`
+	Forbidden   bool     `json:"forbidden"`
+}
+
+func createObligations(w io.Writer, obligations []licenses.Obligation) error {
+	write, ok := obligationsWriters[*formatFlag]
+	if !ok {
+		return fmt.Errorf("unknown obligations format %q", *formatFlag)
+	}
+	return write(w, obligations)
+}
+
+func writeTextObligations(w io.Writer, obligations []licenses.Obligation) error {
+	writer := tabwriter.NewWriter(w, 1, 4, 2, ' ', 0)
+
+	for _, obligation := range obligations {
+		text := fmt.Sprintf("%s: %s\n", obligation.Name, obligation.Description)
+		if obligation.Forbidden {
+			text = fmt.Sprintf("%s (forbidden): %s\n", obligation.Name, obligation.Description)
+		}
+		for _, module := range obligation.Modules {
+			text += fmt.Sprintf("\t%s\n", module)
+		}
+
+		_, err := writer.Write([]byte(text + "\n"))
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return nil
+}
+
+func writeJSONObligations(w io.Writer, obligations []licenses.Obligation) error {
+	doc := jsonObligations{
+		FormatVersion: jsonFormatVersion,
+		Generator:     "go-vendor-licenses " + version,
+		Obligations:   []jsonObligation{},
+	}
+	for _, obligation := range obligations {
+		doc.Obligations = append(doc.Obligations, jsonObligation{
+			Name:        obligation.Name,
+			Description: obligation.Description,
+			Modules:     obligation.Modules,
+			Forbidden:   obligation.Forbidden,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(doc)
+}
@@ -0,0 +1,205 @@
+/*
+ * go-vendor-licenses - packages.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// target is a platform the packages are built for. Empty fields default to
+// the environment, like with the go command.
+type target struct {
+	goos   string
+	goarch string
+}
+
+func (t target) String() string {
+	goos, goarch := t.goos, t.goarch
+	if goos == "" {
+		goos = "default"
+	}
+	if goarch == "" {
+		goarch = "default"
+	}
+	return goos + "/" + goarch
+}
+
+// parseTargets parses a comma separated list of targets like
+// "linux/arm,linux/amd64".
+func parseTargets(list string) ([]target, error) {
+	targets := []target{}
+	for _, item := range strings.Split(list, ",") {
+		parts := strings.Split(strings.TrimSpace(item), "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid target %q, expected GOOS/GOARCH", item)
+		}
+		targets = append(targets, target{goos: parts[0], goarch: parts[1]})
+	}
+	return targets, nil
+}
+
+// goList runs go list for the target with the arguments and returns the
+// lines of its output.
+func goList(t target, args ...string) ([]string, error) {
+	cmd := exec.Command("go", append([]string{"list"}, args...)...)
+	cmd.Env = append(os.Environ(), "GO111MODULE=on")
+	if t.goos != "" {
+		cmd.Env = append(cmd.Env, "GOOS="+t.goos)
+	}
+	if t.goarch != "" {
+		cmd.Env = append(cmd.Env, "GOARCH="+t.goarch)
+	}
+	stderr := bytes.Buffer{}
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list %s: %s\n%s", strings.Join(args, " "), err, stderr.String())
+	}
+
+	lines := []string{}
+	for _, line := range strings.Split(string(output), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+// listFormat prints the import path, name and module of each package. The
+// packages matched by the patterns, which are not only dependencies, are
+// marked as root and print their dependencies as well.
+const listFormat = "{{.ImportPath}}\t{{.Name}}\t{{with .Module}}{{.Path}}{{end}}\t" +
+	"{{if not .DepOnly}}root\t{{join .Deps \" \"}}{{end}}"
+
+// packageModules returns the modules providing the packages compiled into
+// the packages matched by the patterns for the target, test dependencies are
+// not. They are mapped to the matched packages which use them. With mainOnly,
+// only main packages are regarded.
+func packageModules(t target, tags string, patterns []string, mainOnly bool) (map[string]map[string]bool, error) {
+	lines, err := goList(t, append([]string{"-deps", "-tags", tags, "-f", listFormat}, patterns...)...)
+	if err != nil {
+		return nil, err
+	}
+	return listedModules(lines, mainOnly)
+}
+
+// listedModules maps the modules of the packages in the lines of go list with
+// listFormat to the root packages using them.
+func listedModules(lines []string, mainOnly bool) (map[string]map[string]bool, error) {
+	modules := map[string]string{}
+	roots := map[string][]string{}
+	for _, line := range lines {
+		// Lines are trimmed, as are trailing empty fields
+		fields := strings.Split(line, "\t")
+		if len(fields) > 5 {
+			return nil, fmt.Errorf("Unexpected output of go list: %s", line)
+		}
+		for len(fields) < 5 {
+			fields = append(fields, "")
+		}
+		if fields[2] != "" {
+			modules[fields[0]] = fields[2]
+		}
+		if fields[3] == "root" && (!mainOnly || fields[1] == "main") {
+			roots[fields[0]] = strings.Fields(fields[4])
+		}
+	}
+
+	ret := map[string]map[string]bool{}
+	for pkg, deps := range roots {
+		for _, dep := range append(deps, pkg) {
+			module, found := modules[dep]
+			if !found {
+				continue
+			}
+			if ret[module] == nil {
+				ret[module] = map[string]bool{}
+			}
+			ret[module][pkg] = true
+		}
+	}
+	return ret, nil
+}
+
+// filterPackageModules reduces the manifest to the modules of the packages
+// matched by the patterns built for any of the targets with the build tags.
+// Without patterns, the main packages of the main modules are regarded. It
+// records which packages use each module, and for several targets which of
+// them. Main modules not built into the packages, like other members of a
+// workspace, are dropped as well.
+func filterPackageModules(manifest []metadata, patterns []string, targets []target, tags string) ([]metadata, error) {
+	mainOnly := len(patterns) == 0
+	if mainOnly {
+		patterns = defaultPatterns(manifest)
+	}
+	packages := map[string]map[string]bool{}
+	platforms := map[string]map[string]bool{}
+	for _, t := range targets {
+		modules, err := packageModules(t, tags, patterns, mainOnly)
+		if err != nil {
+			return nil, err
+		}
+		for module, pkgs := range modules {
+			if packages[module] == nil {
+				packages[module] = map[string]bool{}
+				platforms[module] = map[string]bool{}
+			}
+			for pkg := range pkgs {
+				packages[module][pkg] = true
+			}
+			platforms[module][t.String()] = true
+		}
+	}
+	if mainOnly && len(packages) == 0 {
+		return nil, fmt.Errorf("No main packages in the main modules, the packages have to be given")
+	}
+
+	ret := []metadata{}
+	for _, meta := range manifest {
+		if packages[meta.name] == nil {
+			continue
+		}
+		meta.packages = sortedKeys(packages[meta.name])
+		if len(targets) > 1 {
+			meta.targets = sortedKeys(platforms[meta.name])
+		}
+		ret = append(ret, meta)
+	}
+	return ret, nil
+}
+
+// defaultPatterns returns the patterns of all packages of the main modules,
+// of which filterPackageModules regards the main packages only. In a
+// workspace, ./... does not match the packages of its modules.
+func defaultPatterns(manifest []metadata) []string {
+	patterns := []string{}
+	for _, meta := range manifest {
+		if meta.main {
+			patterns = append(patterns, filepath.Join(meta.path, "..."))
+		}
+	}
+	return patterns
+}
+
+func sortedKeys(set map[string]bool) []string {
+	if len(set) == 0 {
+		return nil
+	}
+	keys := []string{}
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
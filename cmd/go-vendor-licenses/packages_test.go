@@ -0,0 +1,68 @@
+/*
+ * go-vendor-licenses - packages_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListedModules(t *testing.T) {
+	// go list -deps output with listFormat, trimmed like by goList
+	lines := []string{
+		"errors\terrors",
+		"github.com/pkg/errors\terrors\tgithub.com/pkg/errors",
+		"github.com/google/uuid\tuuid\tgithub.com/google/uuid",
+		"example.com/m/lib\tlib\texample.com/m\troot\tgithub.com/google/uuid",
+		"example.com/m/cmd/a\tmain\texample.com/m\troot\terrors github.com/pkg/errors",
+		"example.com/m/cmd/b\tmain\texample.com/m\troot",
+	}
+	tests := []struct {
+		name     string
+		mainOnly bool
+		want     map[string]map[string]bool
+	}{
+		{"all packages", false, map[string]map[string]bool{
+			"example.com/m":          {"example.com/m/lib": true, "example.com/m/cmd/a": true, "example.com/m/cmd/b": true},
+			"github.com/pkg/errors":  {"example.com/m/cmd/a": true},
+			"github.com/google/uuid": {"example.com/m/lib": true},
+		}},
+		{"main packages", true, map[string]map[string]bool{
+			"example.com/m":         {"example.com/m/cmd/a": true, "example.com/m/cmd/b": true},
+			"github.com/pkg/errors": {"example.com/m/cmd/a": true},
+		}},
+	}
+	for _, test := range tests {
+		modules, err := listedModules(lines, test.mainOnly)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(modules, test.want) {
+			t.Errorf("%s: modules %v, want %v", test.name, modules, test.want)
+		}
+	}
+}
+
+func TestParseTargets(t *testing.T) {
+	tests := []struct {
+		list    string
+		targets []target
+	}{
+		{"linux/arm", []target{{"linux", "arm"}}},
+		{"linux/arm, windows/amd64", []target{{"linux", "arm"}, {"windows", "amd64"}}},
+		{"linux", nil},
+		{"linux/", nil},
+		{"linux/arm/v7", nil},
+	}
+	for _, test := range tests {
+		targets, err := parseTargets(test.list)
+		if (err != nil) != (test.targets == nil) || !reflect.DeepEqual(targets, test.targets) {
+			t.Errorf("parseTargets(%q) = %v, %v, want %v", test.list, targets, err, test.targets)
+		}
+	}
+}
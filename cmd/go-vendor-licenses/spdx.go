@@ -0,0 +1,299 @@
+/*
+ * go-vendor-licenses - spdx.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/tq-systems/go-vendor-licenses/licenses"
+)
+
+const (
+	spdxVersion     = "SPDX-2.3"
+	spdxNoAssertion = "NOASSERTION"
+	spdxDocumentID  = "SPDXRef-DOCUMENT"
+)
+
+var regexSPDXIDChars = regexp.MustCompile(`[^A-Za-z0-9.\-]+`)
+
+type spdxDocument struct {
+	SPDXVersion       string                 `json:"spdxVersion"`
+	DataLicense       string                 `json:"dataLicense"`
+	SPDXID            string                 `json:"SPDXID"`
+	Name              string                 `json:"name"`
+	DocumentNamespace string                 `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo       `json:"creationInfo"`
+	Packages          []spdxPackage          `json:"packages"`
+	ExtractedLicenses []spdxExtractedLicense `json:"hasExtractedLicensingInfos,omitempty"`
+	Relationships     []spdxRelationship     `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Creators           []string `json:"creators"`
+	Created            string   `json:"created"`
+	LicenseListVersion string   `json:"licenseListVersion"`
+}
+
+type spdxPackage struct {
+	Name             string `json:"name"`
+	SPDXID           string `json:"SPDXID"`
+	VersionInfo      string `json:"versionInfo,omitempty"`
+	DownloadLocation string `json:"downloadLocation"`
+	FilesAnalyzed    bool   `json:"filesAnalyzed"`
+	LicenseConcluded string `json:"licenseConcluded"`
+	LicenseDeclared  string `json:"licenseDeclared"`
+	CopyrightText    string `json:"copyrightText"`
+}
+
+type spdxExtractedLicense struct {
+	LicenseID     string `json:"licenseId"`
+	ExtractedText string `json:"extractedText"`
+	Name          string `json:"name"`
+}
+
+type spdxRelationship struct {
+	Element        string `json:"spdxElementId"`
+	Type           string `json:"relationshipType"`
+	RelatedElement string `json:"relatedSpdxElement"`
+}
+
+// newUUID returns a random (version 4) UUID.
+func newUUID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// rootModule returns the module the manifest was created for. Manifests read
+// from Gopkg.lock have no such entry, so one is made up from the working
+// directory.
+func rootModule(manifest []metadata) metadata {
+	for _, meta := range manifest {
+		if meta.main {
+			return meta
+		}
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		cwd = "unknown"
+	}
+	return metadata{name: filepath.Base(cwd), path: cwd, main: true}
+}
+
+// spdxLicense returns the SPDX license expression of a manifest entry and
+// the extracted licenses, whose identifiers are not on the SPDX license list.
+func spdxLicense(meta metadata) (string, []spdxExtractedLicense) {
+	result := meta.detected
+	if result == nil || result.Expression == "" {
+		return spdxNoAssertion, nil
+	}
+
+	extracted := []spdxExtractedLicense{}
+	for _, license := range result.Licenses {
+		id := license.Template.SPDXID
+		if !license.IsConfident() || !strings.HasPrefix(id, "LicenseRef-") {
+			continue
+		}
+		var text string
+		if license.Tag {
+			// The tag is in a source file, which is no license text
+			file, err := filepath.Rel(meta.path, license.Path)
+			if err != nil {
+				file = filepath.Base(license.Path)
+			}
+			text = fmt.Sprintf("Declared by the SPDX-License-Identifier tag %s in %s",
+				id, filepath.ToSlash(file))
+		} else {
+			data, err := ioutil.ReadFile(license.Path)
+			if err != nil {
+				return spdxNoAssertion, nil
+			}
+			text = string(data)
+		}
+		extracted = append(extracted, spdxExtractedLicense{
+			LicenseID:     id,
+			ExtractedText: text,
+			Name:          license.Template.Title,
+		})
+	}
+	return result.Expression, extracted
+}
+
+// spdxCopyrightText returns the copyrights of the package, one per line.
+func spdxCopyrightText(meta metadata) string {
+	if meta.detected == nil || len(meta.detected.Copyrights) == 0 {
+		return spdxNoAssertion
+	}
+	lines := []string{}
+	for _, copyright := range meta.detected.Copyrights {
+		lines = append(lines, copyright.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func buildSPDXDocument(manifest []metadata) spdxDocument {
+	root := rootModule(manifest)
+
+	doc := spdxDocument{
+		SPDXVersion:       spdxVersion,
+		DataLicense:       "CC0-1.0",
+		SPDXID:            spdxDocumentID,
+		Name:              root.name,
+		DocumentNamespace: "https://spdx.org/spdxdocs/go-vendor-licenses/" + root.name + "-" + newUUID(),
+		CreationInfo: spdxCreationInfo{
+			Creators: []string{"Tool: go-vendor-licenses-" + version},
+			Created:  time.Now().UTC().Format(time.RFC3339),
+			// SPDX only states the major and minor version
+			LicenseListVersion: strings.Join(strings.SplitN(licenses.SPDXLicenseListVersion, ".", 3)[:2], "."),
+		},
+		Packages:      []spdxPackage{},
+		Relationships: []spdxRelationship{},
+	}
+
+	entries := []metadata{root}
+	for _, meta := range manifest {
+		if !meta.main || meta.name != root.name {
+			entries = append(entries, meta)
+		}
+	}
+
+	ids := map[string]bool{}
+	extracted := map[string]bool{}
+	rootID := ""
+	for _, meta := range entries {
+		base := "SPDXRef-Package-" + strings.Trim(regexSPDXIDChars.ReplaceAllString(meta.name, "-"), "-")
+		id := base
+		for n := 2; ids[id]; n++ {
+			id = fmt.Sprintf("%s-%d", base, n)
+		}
+		ids[id] = true
+
+		pkgVersion := meta.version
+		if pkgVersion == "" {
+			pkgVersion = meta.revision
+		}
+
+		license, extractedLicenses := spdxLicense(meta)
+		for _, extractedLicense := range extractedLicenses {
+			if !extracted[extractedLicense.LicenseID] {
+				extracted[extractedLicense.LicenseID] = true
+				doc.ExtractedLicenses = append(doc.ExtractedLicenses, extractedLicense)
+			}
+		}
+
+		doc.Packages = append(doc.Packages, spdxPackage{
+			Name:             meta.name,
+			SPDXID:           id,
+			VersionInfo:      pkgVersion,
+			DownloadLocation: spdxNoAssertion,
+			FilesAnalyzed:    false,
+			LicenseConcluded: license,
+			LicenseDeclared:  license,
+			CopyrightText:    spdxCopyrightText(meta),
+		})
+
+		// Other main modules of a workspace are described as well, instead of
+		// being dependencies of the root
+		if rootID == "" || meta.main {
+			if rootID == "" {
+				rootID = id
+			}
+			doc.Relationships = append(doc.Relationships, spdxRelationship{
+				Element:        spdxDocumentID,
+				Type:           "DESCRIBES",
+				RelatedElement: id,
+			})
+		} else {
+			doc.Relationships = append(doc.Relationships, spdxRelationship{
+				Element:        rootID,
+				Type:           "DEPENDS_ON",
+				RelatedElement: id,
+			})
+		}
+	}
+
+	return doc
+}
+
+func writeSPDXJSON(w io.Writer, manifest []metadata) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(buildSPDXDocument(manifest))
+}
+
+// spdxText wraps multi-line values in <text> tags as required by the
+// tag-value format.
+func spdxText(value string) string {
+	if strings.Contains(value, "\n") || strings.Contains(value, "text>") {
+		return spdxTextBlock(value)
+	}
+	return value
+}
+
+// spdxTextBlock wraps value in <text> tags. The tag-value format has no
+// escaping, so closing tags in the value are written as "&lt;/text&gt;" to
+// keep them from ending the block early.
+func spdxTextBlock(value string) string {
+	return "<text>" + strings.ReplaceAll(value, "</text>", "&lt;/text&gt;") + "</text>"
+}
+
+func writeSPDXTagValue(w io.Writer, manifest []metadata) error {
+	doc := buildSPDXDocument(manifest)
+	writer := bufio.NewWriter(w)
+
+	fmt.Fprintf(writer, "SPDXVersion: %s\n", doc.SPDXVersion)
+	fmt.Fprintf(writer, "DataLicense: %s\n", doc.DataLicense)
+	fmt.Fprintf(writer, "SPDXID: %s\n", doc.SPDXID)
+	fmt.Fprintf(writer, "DocumentName: %s\n", doc.Name)
+	fmt.Fprintf(writer, "DocumentNamespace: %s\n", doc.DocumentNamespace)
+	for _, creator := range doc.CreationInfo.Creators {
+		fmt.Fprintf(writer, "Creator: %s\n", creator)
+	}
+	fmt.Fprintf(writer, "Created: %s\n", doc.CreationInfo.Created)
+	fmt.Fprintf(writer, "LicenseListVersion: %s\n", doc.CreationInfo.LicenseListVersion)
+
+	for _, pkg := range doc.Packages {
+		fmt.Fprintf(writer, "\nPackageName: %s\n", pkg.Name)
+		fmt.Fprintf(writer, "SPDXID: %s\n", pkg.SPDXID)
+		if pkg.VersionInfo != "" {
+			fmt.Fprintf(writer, "PackageVersion: %s\n", pkg.VersionInfo)
+		}
+		fmt.Fprintf(writer, "PackageDownloadLocation: %s\n", pkg.DownloadLocation)
+		fmt.Fprintf(writer, "FilesAnalyzed: %t\n", pkg.FilesAnalyzed)
+		fmt.Fprintf(writer, "PackageLicenseConcluded: %s\n", pkg.LicenseConcluded)
+		fmt.Fprintf(writer, "PackageLicenseDeclared: %s\n", pkg.LicenseDeclared)
+		fmt.Fprintf(writer, "PackageCopyrightText: %s\n", spdxText(pkg.CopyrightText))
+	}
+
+	for _, license := range doc.ExtractedLicenses {
+		fmt.Fprintf(writer, "\nLicenseID: %s\n", license.LicenseID)
+		fmt.Fprintf(writer, "ExtractedText: %s\n", spdxTextBlock(license.ExtractedText))
+		fmt.Fprintf(writer, "LicenseName: %s\n", license.Name)
+	}
+
+	fmt.Fprintln(writer)
+	for _, rel := range doc.Relationships {
+		fmt.Fprintf(writer, "Relationship: %s %s %s\n", rel.Element, rel.Type, rel.RelatedElement)
+	}
+
+	return writer.Flush()
+}
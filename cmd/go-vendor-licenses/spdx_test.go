@@ -0,0 +1,93 @@
+/*
+ * go-vendor-licenses - spdx_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tq-systems/go-vendor-licenses/licenses"
+)
+
+func TestSPDXLicenseExtractedText(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "LICENSE"), "Custom license text\n")
+	writeFile(t, filepath.Join(dir, "sub", "a.go"),
+		"// SPDX-License-Identifier: LicenseRef-Tagged\n\npackage sub\n\nconst secret = 42\n")
+
+	tests := []struct {
+		name    string
+		license *licenses.License
+		text    string
+	}{
+		{"license file", &licenses.License{
+			Score:    1,
+			Template: &licenses.Template{Title: "Custom", SPDXID: "LicenseRef-Custom"},
+			Path:     filepath.Join(dir, "LICENSE"),
+		}, "Custom license text\n"},
+		{"tag", &licenses.License{
+			Score:    1,
+			Template: &licenses.Template{Title: "LicenseRef-Tagged", SPDXID: "LicenseRef-Tagged"},
+			Path:     filepath.Join(dir, "sub", "a.go"),
+			Tag:      true,
+		}, "Declared by the SPDX-License-Identifier tag LicenseRef-Tagged in sub/a.go"},
+	}
+	for _, test := range tests {
+		meta := metadata{name: "example.com/m", path: dir, detected: &licenses.Result{
+			Licenses:   []*licenses.License{test.license},
+			Expression: test.license.Template.SPDXID,
+		}}
+		expression, extracted := spdxLicense(meta)
+		if expression != test.license.Template.SPDXID || len(extracted) != 1 {
+			t.Fatalf("%s: license %s with %d extracted licenses", test.name, expression, len(extracted))
+		}
+		if text := extracted[0].ExtractedText; text != test.text {
+			t.Errorf("%s: extracted text %q, want %q", test.name, text, test.text)
+		}
+		if strings.Contains(extracted[0].ExtractedText, "package sub") {
+			t.Errorf("%s: source code extracted", test.name)
+		}
+	}
+}
+
+func TestSPDXTagValueEscapesTextBlocks(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "LICENSE"), "Custom license</text>\nLicenseName: Forged\n")
+
+	manifest := []metadata{{name: "example.com/m", path: dir, main: true, detected: &licenses.Result{
+		Licenses: []*licenses.License{{
+			Score:    1,
+			Template: &licenses.Template{Title: "Custom", SPDXID: "LicenseRef-Custom"},
+			Path:     filepath.Join(dir, "LICENSE"),
+		}},
+		Expression: "LicenseRef-Custom",
+	}}}
+	var out bytes.Buffer
+	if err := writeSPDXTagValue(&out, manifest); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "ExtractedText: <text>Custom license&lt;/text&gt;\nLicenseName: Forged\n</text>\n") {
+		t.Errorf("closing tag in extracted text not escaped:\n%s", out.String())
+	}
+	if n := strings.Count(out.String(), "</text>"); n != 1 {
+		t.Errorf("%d closing tags, want 1", n)
+	}
+
+	for value, want := range map[string]string{
+		"Copyright 2026 Acme": "Copyright 2026 Acme",
+		"a\nb":                "<text>a\nb</text>",
+		"a</text>":            "<text>a&lt;/text&gt;</text>",
+		"<text>a":             "<text><text>a</text>",
+	} {
+		if got := spdxText(value); got != want {
+			t.Errorf("spdxText(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+/*
+ * go-vendor-licenses - stdlib.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// stdlibName is the name of the entry of the Go distribution, whose runtime
+// and standard library are linked into every binary. It is the name used
+// for it by package URLs.
+const stdlibName = "stdlib"
+
+// goEnv returns the value of a go environment variable, or an empty string
+// without go command.
+func goEnv(name string) string {
+	output, err := exec.Command("go", "env", name).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// goRoot returns the root directory of the Go distribution.
+func goRoot() string {
+	if root := goEnv("GOROOT"); root != "" {
+		return root
+	}
+	if root := os.Getenv("GOROOT"); root != "" {
+		return root
+	}
+	return runtime.GOROOT()
+}
+
+// readGoRootVersion reads the version from the VERSION file of the
+// distribution, its first line like "go1.21.0".
+func readGoRootVersion(root string) string {
+	file, err := os.Open(filepath.Join(root, "VERSION"))
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	if scanner.Scan() {
+		return strings.TrimSpace(scanner.Text())
+	}
+	return ""
+}
+
+// goDistribution returns the entry of the Go distribution, whose LICENSE and
+// PATENTS files are found in GOROOT. Without a version, like of the Go
+// release a binary is built with, the version of GOROOT is used.
+func goDistribution(goVersion string) (metadata, error) {
+	root := goRoot()
+	if _, err := os.Stat(filepath.Join(root, "LICENSE")); err != nil {
+		return metadata{}, fmt.Errorf("Go distribution not found: %s", err)
+	}
+	if goVersion == "" {
+		goVersion = goEnv("GOVERSION")
+	}
+	if goVersion == "" {
+		goVersion = readGoRootVersion(root)
+	}
+	return metadata{
+		name:    stdlibName,
+		version: goVersion,
+		path:    root,
+	}, nil
+}
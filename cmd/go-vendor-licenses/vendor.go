@@ -0,0 +1,128 @@
+/*
+ * go-vendor-licenses - vendor.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const vendorModulesFile = "vendor/modules.txt"
+
+// readGoModPath returns the module path declared in the go.mod file.
+func readGoModPath(goMod string) (string, error) {
+	file, err := os.Open(goMod)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], "\""), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%s: no module directive found", goMod)
+}
+
+// readVendorModules reads the modules of vendor/modules.txt, so vendored
+// builds neither need the go command, the module cache nor the network. The
+// paths point into the vendor directory, modules without vendored packages
+// are skipped. The main module comes first.
+func readVendorModules() ([]metadata, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	mainPath, err := readGoModPath("go.mod")
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(vendorModulesFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	modules := []*metadata{}
+	byName := map[string]*metadata{}
+	var current *metadata
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		switch {
+		case strings.HasPrefix(text, "## "):
+			// Annotations of the current module, like "## explicit; go 1.21"
+			if current == nil {
+				continue
+			}
+			for _, annotation := range strings.Split(text[len("## "):], ";") {
+				if strings.TrimSpace(annotation) == "explicit" {
+					current.explicit = true
+				}
+			}
+		case strings.HasPrefix(text, "# "):
+			fields := strings.Fields(text[len("# "):])
+			replace := ""
+			for k, field := range fields {
+				if field == "=>" {
+					replace = strings.Join(fields[k+1:], " ")
+					fields = fields[:k]
+					break
+				}
+			}
+			if len(fields) == 0 || len(fields) > 2 {
+				return nil, fmt.Errorf("%s:%d: invalid module line %q", vendorModulesFile, line, text)
+			}
+
+			if len(fields) == 1 {
+				// Replacements of all versions of a module are listed
+				// without version after the modules
+				current = nil
+				if meta, ok := byName[fields[0]]; ok && meta.replace == "" {
+					meta.replace = replace
+				}
+				continue
+			}
+			current = &metadata{
+				name:    fields[0],
+				version: fields[1],
+				path:    filepath.Join(cwd, "vendor", filepath.FromSlash(fields[0])),
+				replace: replace,
+			}
+			modules = append(modules, current)
+			byName[current.name] = current
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	ret := []metadata{{
+		name: mainPath,
+		path: cwd,
+		main: true,
+	}}
+	for _, meta := range modules {
+		if info, err := os.Stat(meta.path); err != nil || !info.IsDir() {
+			// skip modules without vendored packages
+			continue
+		}
+		ret = append(ret, *meta)
+	}
+	return ret, nil
+}
@@ -0,0 +1,117 @@
+/*
+ * go-vendor-licenses - vendor_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// chdir changes into dir for the rest of the test.
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(cwd) })
+}
+
+func TestReadVendorModules(t *testing.T) {
+	tests := []struct {
+		name    string
+		modules string
+		vendor  []string
+		want    []metadata
+	}{
+		{"explicit", `# github.com/pkg/errors v0.9.1
+## explicit
+github.com/pkg/errors
+# golang.org/x/text v0.3.0
+## explicit; go 1.17
+golang.org/x/text/language
+`, []string{"github.com/pkg/errors", "golang.org/x/text"}, []metadata{
+			{name: "github.com/pkg/errors", version: "v0.9.1", explicit: true},
+			{name: "golang.org/x/text", version: "v0.3.0", explicit: true},
+		}},
+		{"indirect", `# github.com/pkg/errors v0.9.1
+github.com/pkg/errors
+`, []string{"github.com/pkg/errors"}, []metadata{
+			{name: "github.com/pkg/errors", version: "v0.9.1"},
+		}},
+		{"replaced version", `# github.com/pkg/errors v0.9.1 => github.com/fork/errors v0.9.2
+## explicit
+github.com/pkg/errors
+`, []string{"github.com/pkg/errors"}, []metadata{
+			{name: "github.com/pkg/errors", version: "v0.9.1", replace: "github.com/fork/errors v0.9.2", explicit: true},
+		}},
+		{"replaced module", `# github.com/pkg/errors v0.9.1 => ../errors
+## explicit
+github.com/pkg/errors
+# github.com/pkg/errors => ../errors
+`, []string{"github.com/pkg/errors"}, []metadata{
+			{name: "github.com/pkg/errors", version: "v0.9.1", replace: "../errors", explicit: true},
+		}},
+		{"all versions replaced", `# github.com/pkg/errors v0.9.1
+github.com/pkg/errors
+# github.com/pkg/errors => ../errors
+`, []string{"github.com/pkg/errors"}, []metadata{
+			{name: "github.com/pkg/errors", version: "v0.9.1", replace: "../errors"},
+		}},
+		{"without packages", `# github.com/pkg/errors v0.9.1
+## explicit
+# golang.org/x/text v0.3.0
+golang.org/x/text/language
+`, []string{"golang.org/x/text"}, []metadata{
+			{name: "golang.org/x/text", version: "v0.3.0"},
+		}},
+	}
+	for _, test := range tests {
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "go.mod"), goMod("example.com/main"))
+		writeFile(t, filepath.Join(dir, vendorModulesFile), test.modules)
+		for _, module := range test.vendor {
+			writeFile(t, filepath.Join(dir, "vendor", filepath.FromSlash(module), "LICENSE"), "license\n")
+		}
+		chdir(t, dir)
+		// The temporary directory may be reached through a symbolic link
+		cwd, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		modules, err := readVendorModules()
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		want := []metadata{{name: "example.com/main", path: cwd, main: true}}
+		for _, meta := range test.want {
+			meta.path = filepath.Join(cwd, "vendor", filepath.FromSlash(meta.name))
+			want = append(want, meta)
+		}
+		if !reflect.DeepEqual(modules, want) {
+			t.Errorf("%s: modules %+v, want %+v", test.name, modules, want)
+		}
+	}
+}
+
+func TestReadVendorModulesInvalid(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "go.mod"), goMod("example.com/main"))
+	writeFile(t, filepath.Join(dir, vendorModulesFile), "# a b c\n")
+	chdir(t, dir)
+	if _, err := readVendorModules(); err == nil {
+		t.Errorf("invalid module line accepted")
+	}
+}
@@ -0,0 +1,112 @@
+/*
+ * go-vendor-licenses - workspace.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	licenses "github.com/tq-systems/go-vendor-licenses/licenses"
+)
+
+// manifestExtensions are the file name extensions of the manifest formats.
+var manifestExtensions = map[string]string{
+	"text":          ".txt",
+	"json":          ".json",
+	"spdx":          ".spdx",
+	"spdx-json":     ".spdx.json",
+	"cyclonedx":     ".cdx.json",
+	"cyclonedx-xml": ".cdx.xml",
+}
+
+// outputExtension returns the file name extension of the selected mode and
+// format.
+func outputExtension() string {
+	switch {
+	case *manifestFlag:
+		return manifestExtensions[*formatFlag]
+	case *obligationsFlag && *formatFlag == "json":
+		return ".json"
+	}
+	return ".txt"
+}
+
+// memberManifest returns the part of the manifest built into the packages
+// of the main module member, which comes first. The Go distribution is kept
+// if added.
+func memberManifest(manifest []metadata, member metadata, targets []target) ([]metadata, error) {
+	modules, err := filterPackageModules(manifest, []string{filepath.Join(member.path, "...")}, targets, *tagsFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := []metadata{}
+	for _, meta := range modules {
+		if meta.name == member.name {
+			ret = append([]metadata{meta}, ret...)
+		} else {
+			ret = append(ret, meta)
+		}
+	}
+	for _, meta := range manifest {
+		if meta.name == stdlibName && !meta.main {
+			ret = append(ret, meta)
+		}
+	}
+	return ret, nil
+}
+
+// writeMembers writes the output for each main module, the members of a
+// workspace, to a file in dir named after its module path. The outputs are
+// taken from the report of the whole manifest.
+func writeMembers(dir string, manifest []metadata, report *licenses.Report, targets []target) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	modules := map[string]*licenses.ModuleReport{}
+	for k, meta := range manifest {
+		modules[meta.name] = report.Modules[k]
+	}
+
+	members := 0
+	for _, member := range manifest {
+		if !member.main {
+			continue
+		}
+		members++
+
+		sub, err := memberManifest(manifest, member, targets)
+		if err != nil {
+			return err
+		}
+		subReport := &licenses.Report{}
+		for _, meta := range sub {
+			subReport.Modules = append(subReport.Modules, modules[meta.name])
+		}
+
+		file := filepath.Join(dir, strings.Replace(member.name, "/", "_", -1)+outputExtension())
+		output, err := os.Create(file)
+		if err != nil {
+			return err
+		}
+		if err := writeOutput(output, sub, subReport); err != nil {
+			output.Close()
+			return err
+		}
+		if err := output.Close(); err != nil {
+			return err
+		}
+	}
+	if members == 0 {
+		return fmt.Errorf("no main module to write an output for")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+/*
+ * go-vendor-licenses - workspace_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// workspaceManifest is the manifest of a workspace with the members a and b.
+var workspaceManifest = []metadata{
+	{name: "example.com/a", path: "/ws/a", main: true},
+	{name: "example.com/b", path: "/ws/b", main: true},
+	{name: "github.com/pkg/errors", version: "v0.9.1"},
+}
+
+func TestSPDXDescribesWorkspaceMembers(t *testing.T) {
+	doc := buildSPDXDocument(workspaceManifest)
+	relationships := []string{}
+	for _, relationship := range doc.Relationships {
+		relationships = append(relationships,
+			relationship.Element+" "+relationship.Type+" "+relationship.RelatedElement)
+	}
+	want := []string{
+		"SPDXRef-DOCUMENT DESCRIBES SPDXRef-Package-example.com-a",
+		"SPDXRef-DOCUMENT DESCRIBES SPDXRef-Package-example.com-b",
+		"SPDXRef-Package-example.com-a DEPENDS_ON SPDXRef-Package-github.com-pkg-errors",
+	}
+	if !reflect.DeepEqual(relationships, want) {
+		t.Errorf("relationships %q, want %q", relationships, want)
+	}
+}
+
+func TestCycloneDXNestsWorkspaceMembers(t *testing.T) {
+	bom := buildCycloneDXBOM(workspaceManifest)
+	root := bom.Metadata.Component
+	if root.Name != "example.com/a" || root.Type != "application" {
+		t.Errorf("root component %s (%s), want example.com/a (application)", root.Name, root.Type)
+	}
+	if len(root.Components) != 1 || root.Components[0].Name != "example.com/b" || root.Components[0].Type != "application" {
+		t.Errorf("sub-components %v, want example.com/b", root.Components)
+	}
+	if len(bom.Components) != 1 || bom.Components[0].Name != "github.com/pkg/errors" || bom.Components[0].Type != "library" {
+		t.Errorf("components %v, want github.com/pkg/errors", bom.Components)
+	}
+	if dependsOn := bom.Dependencies[0].DependsOn; len(dependsOn) != 1 || dependsOn[0].Ref != "pkg:golang/github.com/pkg/errors@v0.9.1" {
+		t.Errorf("root depends on %v, want github.com/pkg/errors", dependsOn)
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/tq-systems/go-vendor-licenses
 
-go 1.13
+go 1.18
@@ -4,6 +4,8 @@ package main
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"go/build"
@@ -12,6 +14,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -24,6 +27,7 @@ type asset struct {
 var (
 	flagVar  = flag.String("var", "", "variable name to use, \"_\" to ignore (default: file basename without extension)")
 	flagWrap = flag.String("wrap", "", "wrapper function or type (default: filename extension)")
+	flagSPDX = flag.String("spdx", "", "convert the licenses of the SPDX license list `LIST` (json/licenses.json of license-list-data) with a file in the text directory next to it")
 )
 
 var prog = filepath.Base(os.Args[0])
@@ -31,8 +35,9 @@ var prog = filepath.Base(os.Args[0])
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
 	fmt.Fprintf(os.Stderr, "  %s [OPTS] FILE..\n", prog)
+	fmt.Fprintf(os.Stderr, "  %s -spdx LIST\n", prog)
 	fmt.Fprintf(os.Stderr, "\n")
-	fmt.Fprintf(os.Stderr, "Creates files FILE.gen.go\n")
+	fmt.Fprintf(os.Stderr, "Creates files FILE.gen.go, or spdx_ID.txt.gen.go for the SPDX licenses\n")
 	fmt.Fprintf(os.Stderr, "\n")
 	fmt.Fprintf(os.Stderr, "Options:\n")
 	flag.PrintDefaults()
@@ -44,6 +49,15 @@ func main() {
 
 	flag.Usage = usage
 	flag.Parse()
+	if *flagSPDX != "" {
+		if flag.NArg() > 0 {
+			log.Fatal("cannot combine -spdx with files")
+		}
+		if err := processSPDX(*flagSPDX); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 	if flag.NArg() == 0 {
 		flag.Usage()
 		os.Exit(2)
@@ -103,7 +117,23 @@ func process(filename, pkg, variable, wrap string) error {
 	}
 	defer src.Close()
 
-	tmp, err := ioutil.TempFile(filepath.Dir(filename), ".tmp.asset-")
+	return write(filename+".gen.go", pkg, variable, wrap, filepath.Base(filename), src)
+}
+
+// write creates the file gen, which embeds the content of src.
+func write(gen, pkg, variable, wrap, name string, src io.Reader) error {
+	content := bytes.Buffer{}
+	fmt.Fprintf(&content, "package %s\n\n", pkg)
+	if err := embed(variable, wrap, name, bufio.NewReader(src), &content); err != nil {
+		return err
+	}
+	return writeFile(gen, &content)
+}
+
+// writeFile atomically creates the file gen with the content, preceded by the
+// warning that it is generated.
+func writeFile(gen string, content io.Reader) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(gen), ".tmp.asset-")
 	if err != nil {
 		return err
 	}
@@ -114,16 +144,11 @@ func process(filename, pkg, variable, wrap string) error {
 	}()
 	defer tmp.Close()
 
-	in := bufio.NewReader(src)
 	out := bufio.NewWriter(tmp)
-
 	if err := autogen(out); err != nil {
 		return err
 	}
-	if _, err := fmt.Fprintf(out, "package %s\n\n", pkg); err != nil {
-		return err
-	}
-	if err := embed(variable, wrap, filepath.Base(filename), in, out); err != nil {
+	if _, err := io.Copy(out, content); err != nil {
 		return err
 	}
 	if err := out.Flush(); err != nil {
@@ -132,7 +157,6 @@ func process(filename, pkg, variable, wrap string) error {
 	if err := tmp.Close(); err != nil {
 		return err
 	}
-	gen := filename + ".gen.go"
 	if err := os.Rename(tmp.Name(), gen); err != nil {
 		return err
 	}
@@ -174,6 +198,179 @@ func embed(variable, wrap, filename string, in io.Reader, out io.Writer) error {
 	return nil
 }
 
+type spdxList struct {
+	Version  string        `json:"licenseListVersion"`
+	Licenses []spdxLicense `json:"licenses"`
+}
+
+type spdxLicense struct {
+	ID         string `json:"licenseId"`
+	Name       string `json:"name"`
+	Reference  string `json:"reference"`
+	Deprecated bool   `json:"isDeprecatedLicenseId"`
+}
+
+// processSPDX converts the license texts of the SPDX license-list-data into
+// templates, which use the SPDX identifier as nickname. The list is expected
+// in json/licenses.json and the texts in text/ID.txt, as in license-list-data.
+// Deprecated identifiers and licenses without text are skipped. Licenses with
+// the same text, like GPL-3.0-only and GPL-3.0-or-later, cannot be told apart,
+// so only the shortest identifier is kept. Licenses which already have a
+// template in the current package become alternative texts of it. The
+// generated files are placed in the current package, together with
+// spdx_list.gen.go, which records the version of the list.
+func processSPDX(listFile string) error {
+	data, err := ioutil.ReadFile(listFile)
+	if err != nil {
+		return err
+	}
+	list := spdxList{}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("%s: %s", listFile, err)
+	}
+	if list.Version == "" {
+		return fmt.Errorf("%s: no licenseListVersion", listFile)
+	}
+
+	pkg, err := loadPkg(".")
+	if err != nil {
+		return err
+	}
+	covered, err := templateIDs(".")
+	if err != nil {
+		return err
+	}
+
+	textDir := filepath.Join(filepath.Dir(filepath.Dir(listFile)), "text")
+	texts := map[string][]byte{}
+	kept := map[string]spdxLicense{}
+	for _, license := range list.Licenses {
+		if license.Deprecated {
+			continue
+		}
+		text, err := ioutil.ReadFile(filepath.Join(textDir, license.ID+".txt"))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		texts[license.ID] = text
+		if other, found := kept[string(text)]; !found || len(license.ID) < len(other.ID) {
+			kept[string(text)] = license
+		}
+	}
+
+	variables := map[string]string{}
+	generated := map[string]bool{}
+	for _, license := range list.Licenses {
+		text, found := texts[license.ID]
+		if !found || kept[string(text)].ID != license.ID {
+			continue
+		}
+
+		template := bytes.Buffer{}
+		if nickname, found := covered[license.ID]; found {
+			fmt.Fprintf(&template, "---\ntitle: %s\nnickname: %s\nspdx-id: %s\nsource: %s\nalternative: true\n---\n\n",
+				license.Name, nickname, license.ID, license.Reference)
+		} else {
+			fmt.Fprintf(&template, "---\ntitle: %s\nnickname: %s\nspdx-id: %s\nsource: %s\n---\n\n",
+				license.Name, license.ID, license.ID, license.Reference)
+		}
+		template.Write(text)
+
+		base := "spdx_" + spdxVariable(license.ID) + ".txt"
+		variable := strings.SplitN(base, ".", 2)[0]
+		if other, found := variables[variable]; found {
+			return fmt.Errorf("%s and %s both map to %s", other, license.ID, variable)
+		}
+		variables[variable] = license.ID
+		generated[base+".gen.go"] = true
+		if err := write(base+".gen.go", pkg.Name, variable, "txt", base, &template); err != nil {
+			return err
+		}
+	}
+
+	// go generate fails if files of the package are removed while it runs,
+	// so licenses which are not converted anymore are only reported
+	files, err := filepath.Glob("spdx_*.txt.gen.go")
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if !generated[file] {
+			log.Printf("%s is stale and has to be removed", file)
+		}
+	}
+
+	version := bytes.Buffer{}
+	fmt.Fprintf(&version, "package %s\n\n", pkg.Name)
+	fmt.Fprintf(&version, "// SPDXLicenseListVersion is the version of the SPDX license list, whose\n")
+	fmt.Fprintf(&version, "// licenses are embedded.\n")
+	fmt.Fprintf(&version, "const SPDXLicenseListVersion = %q\n\n", list.Version)
+	ids := []string{}
+	for _, license := range list.Licenses {
+		ids = append(ids, license.ID)
+	}
+	sort.Strings(ids)
+	fmt.Fprintf(&version, "// SPDXLicenseIDs are the identifiers of the SPDX license list, including\n")
+	fmt.Fprintf(&version, "// the deprecated ones and those whose texts are not embedded.\n")
+	fmt.Fprintf(&version, "var SPDXLicenseIDs = []string{\n")
+	for _, id := range ids {
+		fmt.Fprintf(&version, "\t%q,\n", id)
+	}
+	fmt.Fprintf(&version, "}\n")
+	return writeFile("spdx_list.gen.go", &version)
+}
+
+// templateIDs maps the SPDX identifiers of the templates in dir to their
+// nicknames. The identifier is the spdx-id of the front matter, or else the
+// nickname.
+func templateIDs(dir string) (map[string]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		return nil, err
+	}
+	ids := map[string]string{}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		id, nickname := "", ""
+		for i, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if i > 0 && line == "---" {
+				break
+			}
+			if strings.HasPrefix(line, "spdx-id:") {
+				id = strings.TrimSpace(line[len("spdx-id:"):])
+			} else if strings.HasPrefix(line, "nickname:") {
+				nickname = strings.TrimSpace(line[len("nickname:"):])
+			}
+		}
+		if id == "" {
+			id = nickname
+		}
+		if id != "" {
+			ids[id] = nickname
+		}
+	}
+	return ids, nil
+}
+
+// spdxVariable turns an SPDX identifier into a lower case variable name.
+func spdxVariable(id string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		}
+		return '_'
+	}, id)
+}
+
 func getPkg(packages map[string]*build.Package, dir string) (*build.Package, error) {
 	if pkg, found := packages[dir]; found {
 		return pkg, nil
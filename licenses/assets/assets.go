@@ -23,6 +23,7 @@
 //go:generate asset unlicense.txt
 //go:generate asset wtfpl.txt
 //go:generate asset tqssla_1.0.2.txt
+//go:generate asset -spdx spdx/json/licenses.json
 
 package assets
 
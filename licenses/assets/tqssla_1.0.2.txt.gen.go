@@ -3,5 +3,5 @@
 package assets
 
 var tqssla_1 = txt(asset{Name: "tqssla_1.0.2.txt", Content: "" +
-	"---\ntitle: TQ-Systems Software License Agreement Version 1.0.2\nnickname: TQSSLA-1.0.2\nsource: https://www.tq-group.com/en/Licensing-conditions-for-Software\n\ndescription: The proprietary software license of TQ-Systems.\n\nhidden: true\n\nhow:\n\nrequired:\n  - TQ-Systems hardware\n\npermitted:\n  - commercial-use\n  - private-use\n\nforbidden:\n  - distribution\n\n---\nTQ-Systems Software License Agreement Version 1.0.2\n\nCopyright 2018 TQ-Systems GmbH (TQ), D-82229 Seefeld, Germany. \nAll rights reserved.\n\nIt is permitted to copy and distribute this document true to word.\n\nThe software license is bound to the use of the TQ-Systems hardware.\nThe software is classified as product-specific software.\nThe software is provided exclusively in machine-readable form (binary or \nobject code).\n\n\u00a71 Scope of Use and Usage Period\n  1. The rights to the TQ software - in particular the copyright and \n     additional industrial property rights - shall remain exclusively \n     with TQ even if the software has been modified by you or your \n     company.\n     You are prohibited from using this Software in any way other than \n     as described in the following provisions of this Agreement.\n  2. The ordinary, non-exclusive right to use the Software shall only \n     apply in direct connection with the Product Hardware. Product \n     hardware means that the hardware has been produced by TQ or a \n     company affiliated with TQ and that the software has been developed \n     specifically for that hardware.\n     You are prohibited from using the software developed by us on \n     hardware products from third parties.\n     Any transfer to third parties is bound by the provisions of this \n     Agreement.\n  3. You are obliged to report every activity to TQ in writing, with the \n     exception of those actions which, according to the conditions of \n     the Copyright Act (UrhG), do not require the release of the rights \n     holder.\n     Outside the scope of the cases specified in the Copyright Act \n     (UrhG), you are not entitled to modify, reverse engineer or \n     translate the software.\n     If our software as a work uses software parts of third parties and  \n     therefore paragraph 1, subparagraph 3. contradicts license \n     conditions applicable to these software parts of third parties, we \n     additionally grant you the right to obtain permission to modify our \n     software in binary form for your own use only and to carry out \n     reverse engineering for the purpose of debugging these \n     modifications. You may not distribute the modified version to third \n     parties in any format.\n  4. Any use of the software that goes beyond the scope of the previous \n     provisions requires our written consent.\n  5. We reserve the right to assert claims for damages.\n  6. You are only entitled to sub-license the software in connection \n     with the product hardware.\n  7. In the event of sub-licensing, you must also ensure that no \n     additional rights to the Software are granted to sublicensees other \n     than those granted to you under these License Terms.\n  8. If you transfer your rights to the Software to any third party, you \n     must destroy any untransferred copies of the Software.\n\n\u00a72 Warranty; Liability\n  1. We expressly point out that it is not possible according to the \n     state of the art to develop more complex software in such a way \n     that an error-free function is guaranteed under all application \n     conditions. We therefore only guarantee that the delivered software \n     is suitable for contractual use in accordance with the description \n     created or accepted by us.\n  2. In case of larger and comprehensible deviations of the software \n     functionality from the product description or specification, we are \n     entitled to either refund the purchase price paid by you, or a \n     subsequent performance in the form of a repair within the framework \n     of a reasonable period or to arrange for a replacement delivery of \n     the Product.\n     In the event of significant and reproducible deviations in the \n     functionality of the software from the product description or \n     specification, we shall be entitled, at our discretion, either to \n     refund the purchase price paid by you or to subsequent performance \n     within a reasonable period of time by way of repair or new \n     delivery. If, within the framework of the supplementary performance \n     chosen by us, we are unable to eliminate the considerable \n     deviations in the functionality of the software that have occurred \n     within a reasonable period by providing a defect-free update or \n     upgrade of the software or to circumvent them in such a way that \n     you are able to use the software in accordance with the contract, \n     you may claim a reduction in the agreed remuneration or withdraw \n     from the contract. Any further claims are excluded to the extent \n     permitted by law.\n  3. We do not warrant for minor deviations in the functionality of the \n     software from the product description or specification.\n  4. The warranty period is one (1) year, unless longer periods are \n     required by law. It begins with the acceptance of the software by \n     you or - if a formal acceptance is not planned or does not take \n     place - at the latest two (2) weeks after delivery or actual \n     transfer.\n  5. We do not warrant or assume any liability beyond the scope of use \n     described in paragraph 1 with respect to the proper selection, \n     application and use of the Software. This applies in particular to \n     improper use, in particular the use of incompatible hardware or \n     software, modifications or extensions to the software made \n     independently by or at your instigation, the culpable omission of \n     regular data backup and other cases of incorrect or negligent \n     handling by you. \n     If you use the software outside these license terms, all warranty \n     and liability claims are void. The aforementioned warranty or \n     liability limitations shall not apply in cases of injury to life, \n     limb or health, in cases of liability falling within the scope of \n     the Product Liability Act, insofar as the software lacks a property \n     or quality expressly warranted by us or we are at fault in the form \n     of intent or gross negligence.\n\n\u00a73. Additional conditions\n  1. Copies must contain a literal copy of this document.\n  2. TQ-Systems may revise this License from time to time.\n     Each Revision is identified by a Version number.\n     You may either use this Software within the scope of this version \n     or the license or under the conditions of a later Revision of the \n     license." +
+	"---\ntitle: TQ-Systems Software License Agreement Version 1.0.2\nnickname: TQSSLA-1.0.2\nspdx-id: LicenseRef-TQSSLA-1.0.2\nsource: https://www.tq-group.com/en/Licensing-conditions-for-Software\n\ndescription: The proprietary software license of TQ-Systems.\n\nhidden: true\n\nhow:\n\nrequired:\n  - TQ-Systems hardware\n\npermitted:\n  - commercial-use\n  - private-use\n\nforbidden:\n  - distribution\n\n---\nTQ-Systems Software License Agreement Version 1.0.2\n\nCopyright 2018 TQ-Systems GmbH (TQ), D-82229 Seefeld, Germany. \nAll rights reserved.\n\nIt is permitted to copy and distribute this document true to word.\n\nThe software license is bound to the use of the TQ-Systems hardware.\nThe software is classified as product-specific software.\nThe software is provided exclusively in machine-readable form (binary or \nobject code).\n\n\u00a71 Scope of Use and Usage Period\n  1. The rights to the TQ software - in particular the copyright and \n     additional industrial property rights - shall remain exclusively \n     with TQ even if the software has been modified by you or your \n     company.\n     You are prohibited from using this Software in any way other than \n     as described in the following provisions of this Agreement.\n  2. The ordinary, non-exclusive right to use the Software shall only \n     apply in direct connection with the Product Hardware. Product \n     hardware means that the hardware has been produced by TQ or a \n     company affiliated with TQ and that the software has been developed \n     specifically for that hardware.\n     You are prohibited from using the software developed by us on \n     hardware products from third parties.\n     Any transfer to third parties is bound by the provisions of this \n     Agreement.\n  3. You are obliged to report every activity to TQ in writing, with the \n     exception of those actions which, according to the conditions of \n     the Copyright Act (UrhG), do not require the release of the rights \n     holder.\n     Outside the scope of the cases specified in the Copyright Act \n     (UrhG), you are not entitled to modify, reverse engineer or \n     translate the software.\n     If our software as a work uses software parts of third parties and  \n     therefore paragraph 1, subparagraph 3. contradicts license \n     conditions applicable to these software parts of third parties, we \n     additionally grant you the right to obtain permission to modify our \n     software in binary form for your own use only and to carry out \n     reverse engineering for the purpose of debugging these \n     modifications. You may not distribute the modified version to third \n     parties in any format.\n  4. Any use of the software that goes beyond the scope of the previous \n     provisions requires our written consent.\n  5. We reserve the right to assert claims for damages.\n  6. You are only entitled to sub-license the software in connection \n     with the product hardware.\n  7. In the event of sub-licensing, you must also ensure that no \n     additional rights to the Software are granted to sublicensees other \n     than those granted to you under these License Terms.\n  8. If you transfer your rights to the Software to any third party, you \n     must destroy any untransferred copies of the Software.\n\n\u00a72 Warranty; Liability\n  1. We expressly point out that it is not possible according to the \n     state of the art to develop more complex software in such a way \n     that an error-free function is guaranteed under all application \n     conditions. We therefore only guarantee that the delivered software \n     is suitable for contractual use in accordance with the description \n     created or accepted by us.\n  2. In case of larger and comprehensible deviations of the software \n     functionality from the product description or specification, we are \n     entitled to either refund the purchase price paid by you, or a \n     subsequent performance in the form of a repair within the framework \n     of a reasonable period or to arrange for a replacement delivery of \n     the Product.\n     In the event of significant and reproducible deviations in the \n     functionality of the software from the product description or \n     specification, we shall be entitled, at our discretion, either to \n     refund the purchase price paid by you or to subsequent performance \n     within a reasonable period of time by way of repair or new \n     delivery. If, within the framework of the supplementary performance \n     chosen by us, we are unable to eliminate the considerable \n     deviations in the functionality of the software that have occurred \n     within a reasonable period by providing a defect-free update or \n     upgrade of the software or to circumvent them in such a way that \n     you are able to use the software in accordance with the contract, \n     you may claim a reduction in the agreed remuneration or withdraw \n     from the contract. Any further claims are excluded to the extent \n     permitted by law.\n  3. We do not warrant for minor deviations in the functionality of the \n     software from the product description or specification.\n  4. The warranty period is one (1) year, unless longer periods are \n     required by law. It begins with the acceptance of the software by \n     you or - if a formal acceptance is not planned or does not take \n     place - at the latest two (2) weeks after delivery or actual \n     transfer.\n  5. We do not warrant or assume any liability beyond the scope of use \n     described in paragraph 1 with respect to the proper selection, \n     application and use of the Software. This applies in particular to \n     improper use, in particular the use of incompatible hardware or \n     software, modifications or extensions to the software made \n     independently by or at your instigation, the culpable omission of \n     regular data backup and other cases of incorrect or negligent \n     handling by you. \n     If you use the software outside these license terms, all warranty \n     and liability claims are void. The aforementioned warranty or \n     liability limitations shall not apply in cases of injury to life, \n     limb or health, in cases of liability falling within the scope of \n     the Product Liability Act, insofar as the software lacks a property \n     or quality expressly warranted by us or we are at fault in the form \n     of intent or gross negligence.\n\n\u00a73. Additional conditions\n  1. Copies must contain a literal copy of this document.\n  2. TQ-Systems may revise this License from time to time.\n     Each Revision is identified by a Version number.\n     You may either use this Software within the scope of this version \n     or the license or under the conditions of a later Revision of the \n     license." +
 	""})
@@ -3,5 +3,5 @@
 package assets
 
 var wtfpl = txt(asset{Name: "wtfpl.txt", Content: "" +
-	"---\ntitle: \"Do What The F*ck You Want To Public License\"\nnickname: DWTFYW-2.0\nhidden: true\nsource: http://www.wtfpl.net/\n\ndescription: The easiest licence out there. It gives the user permissions to do whatever they want with your code.\n\nhow: Create a text file (typically named LICENSE or LICENSE.txt) in the root of your source code and copy the text of the license into the file.\n\nrequired: []\n\npermitted:\n  - commercial-use\n  - modifications\n  - distribution\n  - sublicense\n  - private-use\n\nforbidden: []\n\n---\n\n            DO WHAT THE FUCK YOU WANT TO PUBLIC LICENSE\n                    Version 2, December 2004\n\n Copyright (C) 2004 [fullname] <[email]>\n\n Everyone is permitted to copy and distribute verbatim or modified\n copies of this license document, and changing it is allowed as long\n as the name is changed.\n\n            DO WHAT THE FUCK YOU WANT TO PUBLIC LICENSE\n   TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION\n\n  0. You just DO WHAT THE FUCK YOU WANT TO.\n" +
+	"---\ntitle: \"Do What The F*ck You Want To Public License\"\nnickname: DWTFYW-2.0\nspdx-id: WTFPL\nhidden: true\nsource: http://www.wtfpl.net/\n\ndescription: The easiest licence out there. It gives the user permissions to do whatever they want with your code.\n\nhow: Create a text file (typically named LICENSE or LICENSE.txt) in the root of your source code and copy the text of the license into the file.\n\nrequired: []\n\npermitted:\n  - commercial-use\n  - modifications\n  - distribution\n  - sublicense\n  - private-use\n\nforbidden: []\n\n---\n\n            DO WHAT THE FUCK YOU WANT TO PUBLIC LICENSE\n                    Version 2, December 2004\n\n Copyright (C) 2004 [fullname] <[email]>\n\n Everyone is permitted to copy and distribute verbatim or modified\n copies of this license document, and changing it is allowed as long\n as the name is changed.\n\n            DO WHAT THE FUCK YOU WANT TO PUBLIC LICENSE\n   TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION\n\n  0. You just DO WHAT THE FUCK YOU WANT TO.\n" +
 	""})
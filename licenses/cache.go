@@ -0,0 +1,165 @@
+/*
+ * go-vendor-licenses - cache.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// cacheFormat is part of every cache key, so entries written by older
+// versions are not used anymore after the format changed.
+const cacheFormat = 5
+
+// Cache stores identified licenses on disk. Entries are keyed by module path,
+// version, the hashes of the license files and the set of templates, so they
+// never have to be invalidated and are only pruned to save space.
+type Cache struct {
+	dir string
+}
+
+type cacheEntry struct {
+	Module     string         `json:"module"`
+	Version    string         `json:"version"`
+	Licenses   []cacheLicense `json:"licenses"`
+	Expression string         `json:"expression"`
+	Choice     bool           `json:"choice"`
+	Tags       []string       `json:"tags"`
+	Copyrights []Copyright    `json:"copyrights"`
+}
+
+type cacheLicense struct {
+	File         string   `json:"file"`
+	Nickname     string   `json:"nickname"`
+	Score        float64  `json:"score"`
+	ExtraWords   []string `json:"extraWords"`
+	MissingWords []string `json:"missingWords"`
+	// Tag is set for licenses of SPDX-License-Identifier tags, whose
+	// nickname is the SPDX identifier
+	Tag bool `json:"tag"`
+}
+
+// DefaultCacheDir returns the directory of the cache in the cache directory
+// of the user.
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "go-vendor-licenses"), nil
+}
+
+// ModuleCacheDir returns the module cache of the go command, whose modules
+// never change.
+func ModuleCacheDir() string {
+	if dir := os.Getenv("GOMODCACHE"); dir != "" {
+		return dir
+	}
+	gopath := os.Getenv("GOPATH")
+	if gopath == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		gopath = filepath.Join(home, "go")
+	}
+	return filepath.Join(filepath.SplitList(gopath)[0], "pkg", "mod")
+}
+
+// OpenCache opens the cache in dir, which is created if necessary.
+func OpenCache(dir string) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &Cache{dir: dir}, nil
+}
+
+func (cache *Cache) entryPath(key string) string {
+	return filepath.Join(cache.dir, key[:2], key+".json")
+}
+
+func cacheKey(module string, version string, fingerprint string, checkTags bool, sourceCopyrights bool, files []licenseFile) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%d\x00%s\x00%s\x00%s\x00%t\x00%t", cacheFormat, module, version, fingerprint, checkTags, sourceCopyrights)
+	for _, file := range files {
+		fmt.Fprintf(hash, "\x00%s\x00%x", filepath.Base(file.path), sha256.Sum256(file.data))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func (cache *Cache) load(key string) (*cacheEntry, bool) {
+	path := cache.entryPath(key)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	entry := cacheEntry{}
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	// Entries are pruned by the time they were used last
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+	return &entry, true
+}
+
+func (cache *Cache) store(key string, entry *cacheEntry) error {
+	path := cache.entryPath(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	// Write atomically, as several workers may store the same entry
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Prune removes all entries which were not used within maxAge and returns
+// the number of removed entries.
+func (cache *Cache) Prune(maxAge time.Duration) (int, error) {
+	removed := 0
+	deadline := time.Now().Add(-maxAge)
+	err := filepath.Walk(cache.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".json") {
+			return nil
+		}
+		if info.ModTime().Before(deadline) {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			removed++
+		}
+		return nil
+	})
+	return removed, err
+}
@@ -0,0 +1,83 @@
+/*
+ * go-vendor-licenses - cache_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestIdentifyModuleCachesOnlyModuleCache(t *testing.T) {
+	tests := []struct {
+		name   string
+		cached bool
+		want   string
+	}{
+		// A replacement by a directory keeps the required version, but
+		// its sources change
+		{"outside module cache", false, "GPL-3.0-only"},
+		{"inside module cache", true, "MIT OR Apache-2.0"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			detector, err := NewDetector()
+			if err != nil {
+				t.Fatal(err)
+			}
+			cache, err := OpenCache(t.TempDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+			detector.SetCache(cache)
+
+			dir := writeModule(t, map[string]string{
+				"tg.go": "// SPDX-License-Identifier: MIT OR Apache-2.0\n\npackage tg\n",
+			})
+			detector.SetCachedDirs()
+			if test.cached {
+				detector.SetCachedDirs(filepath.Dir(dir))
+			}
+
+			if _, err := detector.IdentifyModule("example.com/tg", "v1.0.0", dir); err != nil {
+				t.Fatal(err)
+			}
+			err = ioutil.WriteFile(filepath.Join(dir, "tg.go"),
+				[]byte("// SPDX-License-Identifier: GPL-3.0-only\n\npackage tg\n"), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+			result, err := detector.IdentifyModule("example.com/tg", "v1.0.0", dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Expression != test.want {
+				t.Errorf("expression %q, want %q", result.Expression, test.want)
+			}
+		})
+	}
+}
+
+func TestIsCached(t *testing.T) {
+	detector := Detector{cachedDirs: []string{"/go/pkg/mod"}}
+	tests := []struct {
+		path   string
+		cached bool
+	}{
+		{"/go/pkg/mod/github.com/pkg/errors@v0.9.1", true},
+		{"/go/pkg/mod", false},
+		{"/go/pkg/mod2/example.com/m@v1.0.0", false},
+		{"/go/pkg/mod/../../src/m", false},
+		{"/work/dir", false},
+	}
+	for _, test := range tests {
+		if cached := detector.isCached(test.path); cached != test.cached {
+			t.Errorf("isCached(%q) = %v, want %v", test.path, cached, test.cached)
+		}
+	}
+}
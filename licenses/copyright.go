@@ -0,0 +1,194 @@
+/*
+ * go-vendor-licenses - copyright.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	regexCopyrightPrefix = regexp.MustCompile(`(?i)^(?:copyright\b|\(c\)|©|:)\s*`)
+	regexCopyrightYears  = regexp.MustCompile(`^(?:\d{4}(?:\s*[-–]\s*(?:\d{4}|\d{2}|present))?(?:\s*[,;/]\s*|\s+|$))+`)
+	regexYearRange       = regexp.MustCompile(`(\d{4})(?:\s*[-–]\s*(\d{4}|\d{2}|present))?`)
+	regexRightsReserved  = regexp.MustCompile(`(?i)\s*all rights reserved\.?`)
+	regexAbbreviation    = regexp.MustCompile(`(?i)\b(?:inc|ltd|co|corp|llc)\.$`)
+)
+
+// Copyright is a copyright statement of a module. Years are normalized to
+// sorted ranges like "2009-2011, 2014" and may be empty.
+type Copyright struct {
+	Years  string `json:"years,omitempty"`
+	Holder string `json:"holder"`
+}
+
+func (copyright Copyright) String() string {
+	if copyright.Years == "" {
+		return "Copyright (c) " + copyright.Holder
+	}
+	return fmt.Sprintf("Copyright (c) %s %s", copyright.Years, copyright.Holder)
+}
+
+// parseCopyright parses a line stating a copyright. Placeholders of license
+// templates, like [fullname], are not copyrights.
+func parseCopyright(line string) (Copyright, bool) {
+	if !regexCopyrightLine.MatchString(line) {
+		return Copyright{}, false
+	}
+	line = strings.TrimLeft(line, " \t#*/")
+	line = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(line), "*/"))
+	for {
+		stripped := regexCopyrightPrefix.ReplaceAllString(line, "")
+		if stripped == line {
+			break
+		}
+		line = stripped
+	}
+
+	years := regexCopyrightYears.FindString(line)
+	holder := regexRightsReserved.ReplaceAllString(line[len(years):], "")
+	holder = strings.TrimPrefix(strings.TrimSpace(holder), "by ")
+	holder = strings.TrimRight(strings.Join(strings.Fields(holder), " "), " ,;")
+	holder = strings.TrimLeft(holder, " ,;:")
+	if !regexAbbreviation.MatchString(holder) {
+		holder = strings.TrimRight(holder, ".")
+	}
+	if holder == "" || strings.HasPrefix(holder, "[") || strings.HasPrefix(holder, "<") {
+		return Copyright{}, false
+	}
+	return Copyright{Years: normalizeYears(parseYears(years)), Holder: holder}, true
+}
+
+func parseYears(text string) map[int]bool {
+	years := map[int]bool{}
+	for _, m := range regexYearRange.FindAllStringSubmatch(text, -1) {
+		from, _ := strconv.Atoi(m[1])
+		to := from
+		switch {
+		case len(m[2]) == 4:
+			to, _ = strconv.Atoi(m[2])
+		case len(m[2]) == 2:
+			to, _ = strconv.Atoi(m[1][:2] + m[2])
+		}
+		// Ranges are expanded to be merged, but not unreasonable ones
+		if to < from || to-from > 100 {
+			to = from
+		}
+		for year := from; year <= to; year++ {
+			years[year] = true
+		}
+	}
+	return years
+}
+
+// normalizeYears formats the years as sorted ranges.
+func normalizeYears(years map[int]bool) string {
+	sorted := []int{}
+	for year := range years {
+		sorted = append(sorted, year)
+	}
+	sort.Ints(sorted)
+
+	ranges := []string{}
+	for k := 0; k < len(sorted); {
+		end := k
+		for end+1 < len(sorted) && sorted[end+1] == sorted[end]+1 {
+			end++
+		}
+		if end > k {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", sorted[k], sorted[end]))
+		} else {
+			ranges = append(ranges, strconv.Itoa(sorted[k]))
+		}
+		k = end + 1
+	}
+	return strings.Join(ranges, ", ")
+}
+
+// copyrightSet collects copyrights, merging the years of the same holder.
+type copyrightSet struct {
+	holders []string
+	names   map[string]string
+	years   map[string]map[int]bool
+	// ignored are holders of the license texts themselves, like the Free
+	// Software Foundation for the GPL
+	ignored map[string]bool
+}
+
+func newCopyrightSet() *copyrightSet {
+	return &copyrightSet{
+		names:   map[string]string{},
+		years:   map[string]map[int]bool{},
+		ignored: map[string]bool{},
+	}
+}
+
+func copyrightKey(holder string) string {
+	return strings.ToLower(strings.TrimRight(holder, "."))
+}
+
+// ignoreTemplate ignores the copyrights stated in the text of a template.
+func (set *copyrightSet) ignoreTemplate(t *Template) {
+	if t == nil {
+		return
+	}
+	for _, line := range strings.Split(t.Text, "\n") {
+		if copyright, ok := parseCopyright(line); ok {
+			set.ignored[copyrightKey(copyright.Holder)] = true
+		}
+	}
+}
+
+// addText adds the copyrights stated in the lines of a text.
+func (set *copyrightSet) addText(lines []string) {
+	for _, line := range lines {
+		copyright, ok := parseCopyright(line)
+		if !ok {
+			continue
+		}
+		key := copyrightKey(copyright.Holder)
+		if set.ignored[key] {
+			continue
+		}
+		if _, ok := set.names[key]; !ok {
+			set.holders = append(set.holders, key)
+			set.names[key] = copyright.Holder
+			set.years[key] = map[int]bool{}
+		}
+		for year := range parseYears(copyright.Years) {
+			set.years[key][year] = true
+		}
+	}
+}
+
+func (set *copyrightSet) copyrights() []Copyright {
+	copyrights := []Copyright{}
+	for _, key := range set.holders {
+		copyrights = append(copyrights, Copyright{
+			Years:  normalizeYears(set.years[key]),
+			Holder: set.names[key],
+		})
+	}
+	return copyrights
+}
+
+// scanSources adds the copyrights stated in the headers of the Go
+// files of the module in path.
+func (set *copyrightSet) scanSources(path string) error {
+	return walkGoFiles(path, func(file string) error {
+		header, err := readHeader(file)
+		if err != nil {
+			return err
+		}
+		set.addText(header)
+		return nil
+	})
+}
@@ -0,0 +1,81 @@
+/*
+ * go-vendor-licenses - copyright_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCopyright(t *testing.T) {
+	tests := []struct {
+		line      string
+		copyright Copyright
+		ok        bool
+	}{
+		{"Copyright (c) 2009 The Go Authors. All rights reserved.",
+			Copyright{"2009", "The Go Authors"}, true},
+		{"// Copyright 2016 Google Inc. All rights reserved.",
+			Copyright{"2016", "Google Inc."}, true},
+		{"Copyright (c) 2014, Google Inc.",
+			Copyright{"2014", "Google Inc."}, true},
+		{" * Copyright (C) 2012-2014, 2016 Example Ltd, all rights reserved",
+			Copyright{"2012-2014, 2016", "Example Ltd"}, true},
+		{"Copyright © 2018 - 2020 by Jane Doe;",
+			Copyright{"2018-2020", "Jane Doe"}, true},
+		{"Copyright (c) 2011-14 Example GmbH.",
+			Copyright{"2011-2014", "Example GmbH"}, true},
+		{"# Copyright (c) Example Project",
+			Copyright{"", "Example Project"}, true},
+		{"Copyright (c) [year] [fullname]", Copyright{}, false},
+		{"Copyright <YEAR> <COPYRIGHT HOLDER>", Copyright{}, false},
+		{"The copyright notice shall be included", Copyright{}, false},
+	}
+	for _, test := range tests {
+		copyright, ok := parseCopyright(test.line)
+		if ok != test.ok || copyright != test.copyright {
+			t.Errorf("parseCopyright(%q) = %#v, %v, want %#v, %v",
+				test.line, copyright, ok, test.copyright, test.ok)
+		}
+	}
+}
+
+func TestNormalizeYears(t *testing.T) {
+	tests := []struct {
+		text  string
+		years string
+	}{
+		{"2009", "2009"},
+		{"2014, 2012-2013", "2012-2014"},
+		{"2009, 2011, 2012, 2015", "2009, 2011-2012, 2015"},
+		{"1998-02", "1998"},
+		{"2010-present", "2010"},
+		{"", ""},
+	}
+	for _, test := range tests {
+		if years := normalizeYears(parseYears(test.text)); years != test.years {
+			t.Errorf("years of %q: %q, want %q", test.text, years, test.years)
+		}
+	}
+}
+
+func TestCopyrightSetMergesHolders(t *testing.T) {
+	set := newCopyrightSet()
+	set.addText([]string{
+		"Copyright (c) 2012 Google Inc. All rights reserved.",
+		"Copyright 2014 google inc.",
+		"Copyright (c) 2013 The Go Authors.",
+	})
+	want := []Copyright{
+		{"2012, 2014", "Google Inc."},
+		{"2013", "The Go Authors"},
+	}
+	if copyrights := set.copyrights(); !reflect.DeepEqual(copyrights, want) {
+		t.Errorf("copyrights %v, want %v", copyrights, want)
+	}
+}
@@ -0,0 +1,359 @@
+/*
+ * go-vendor-licenses - detector.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/tq-systems/go-vendor-licenses/licenses/assets"
+)
+
+// Detector identifies licenses by matching them against the embedded
+// templates. The templates are parsed once, so a single Detector should be
+// shared for all packages. It is safe for concurrent use.
+type Detector struct {
+	templates   []*Template
+	nicknames   map[string]*Template
+	spdxIDs     map[string]*Template
+	fingerprint string
+	cache       *Cache
+	// cachedDirs are the directories of modules which never change, like
+	// the module cache, only modules inside are cached
+	cachedDirs []string
+	checkTags  bool
+	// sourceCopyrights enables scanning the Go files for copyrights
+	sourceCopyrights bool
+}
+
+var (
+	defaultDetectorOnce sync.Once
+	defaultDetectorInst *Detector
+	defaultDetectorErr  error
+)
+
+func defaultDetector() (*Detector, error) {
+	defaultDetectorOnce.Do(func() {
+		defaultDetectorInst, defaultDetectorErr = NewDetector()
+	})
+	return defaultDetectorInst, defaultDetectorErr
+}
+
+// NewDetector parses the embedded license templates.
+func NewDetector() (*Detector, error) {
+	templates, err := loadTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	for _, a := range assets.Assets {
+		hash.Write([]byte(a.Content))
+	}
+
+	detector := Detector{
+		templates:   templates,
+		nicknames:   map[string]*Template{},
+		spdxIDs:     map[string]*Template{},
+		fingerprint: hex.EncodeToString(hash.Sum(nil)),
+	}
+	if dir := ModuleCacheDir(); dir != "" {
+		detector.cachedDirs = []string{dir}
+	}
+	for _, t := range templates {
+		detector.nicknames[t.Nickname] = t
+		detector.spdxIDs[t.SPDXID] = t
+	}
+	return &detector, nil
+}
+
+// SetTagCrossCheck makes the detector scan the SPDX-License-Identifier tags
+// of the Go files even if a package has license files, so they can be
+// compared. Without license files, the tags are always scanned.
+func (detector *Detector) SetTagCrossCheck(enabled bool) {
+	detector.checkTags = enabled
+}
+
+// SetSourceCopyrights makes the detector collect the copyrights stated in
+// the headers of the Go files in addition to those of the license files.
+func (detector *Detector) SetSourceCopyrights(enabled bool) {
+	detector.sourceCopyrights = enabled
+}
+
+// SetCache makes the detector look up and store the results of
+// IdentifyModule in the cache. A nil cache disables caching.
+func (detector *Detector) SetCache(cache *Cache) {
+	detector.cache = cache
+}
+
+// Template returns the template with the given nickname or nil.
+func (detector *Detector) Template(nickname string) *Template {
+	return detector.nicknames[nickname]
+}
+
+// spdxTemplate returns the template of an SPDX license identifier. The
+// embedded templates do not distinguish "-only" and "-or-later" licenses,
+// so their nicknames are tried without suffix. Identifiers without template
+// get a template of their own, which has no text to match.
+func (detector *Detector) spdxTemplate(id string) *Template {
+	if t := detector.lookupSPDXTemplate(id); t != nil {
+		return t
+	}
+	return &Template{
+		Title:    id,
+		Nickname: id,
+		SPDXID:   id,
+	}
+}
+
+func (detector *Detector) lookupSPDXTemplate(id string) *Template {
+	if t, ok := detector.spdxIDs[id]; ok {
+		return t
+	}
+	for _, suffix := range []string{"-only", "-or-later", "+"} {
+		if t, ok := detector.nicknames[strings.TrimSuffix(id, suffix)]; ok {
+			return t
+		}
+	}
+	return nil
+}
+
+// tagScore returns the score of a license identifier of an
+// SPDX-License-Identifier tag. Identifiers which are neither on the SPDX
+// license list nor have a template, like misspelled ones or LicenseRef-*,
+// are not trusted.
+func (detector *Detector) tagScore(id string) float64 {
+	if IsSPDXLicenseID(id) || detector.lookupSPDXTemplate(id) != nil {
+		return 1
+	}
+	return 0
+}
+
+// Identify identifies the licenses of all license files of the package in
+// path.
+func (detector *Detector) Identify(path string) (*Result, error) {
+	files, err := readLicenseFiles(path)
+	if err != nil {
+		return nil, &ReadError{Path: path, Err: err}
+	}
+	return detector.identify(path, files)
+}
+
+// SetCachedDirs sets the directories whose modules are cached, replacing
+// the default, the module cache. Modules in them must never change.
+func (detector *Detector) SetCachedDirs(dirs ...string) {
+	detector.cachedDirs = dirs
+}
+
+// isCached reports whether the module in path may be cached. Modules
+// elsewhere, like replacements by directories, may change anytime, even if
+// their license files do not, like their SPDX tags.
+func (detector *Detector) isCached(path string) bool {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	for _, dir := range detector.cachedDirs {
+		dir, err := filepath.Abs(dir)
+		if err != nil {
+			continue
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// IdentifyModule identifies the licenses of the module version in path. The
+// result is taken from the cache of the detector, if available. Modules
+// without version or outside of the module cache may change anytime and are
+// never cached.
+func (detector *Detector) IdentifyModule(module string, version string, path string) (*Result, error) {
+	if detector.cache == nil || version == "" || !detector.isCached(path) {
+		return detector.Identify(path)
+	}
+
+	files, err := readLicenseFiles(path)
+	if err != nil {
+		return nil, &ReadError{Path: path, Err: err}
+	}
+
+	key := cacheKey(module, version, detector.fingerprint, detector.checkTags, detector.sourceCopyrights, files)
+	if entry, ok := detector.cache.load(key); ok {
+		if result := detector.fromCacheEntry(path, entry); result != nil {
+			return result, nil
+		}
+	}
+
+	result, err := detector.identify(path, files)
+	if err != nil {
+		return nil, err
+	}
+	// A failing cache must not fail the scan, it is just slower
+	_ = detector.cache.store(key, toCacheEntry(module, version, path, result))
+	return result, nil
+}
+
+func (detector *Detector) fromCacheEntry(path string, entry *cacheEntry) *Result {
+	result := Result{
+		Expression: entry.Expression,
+		Choice:     entry.Choice,
+		Tags:       entry.Tags,
+		Copyrights: entry.Copyrights,
+	}
+	for _, l := range entry.Licenses {
+		template := detector.Template(l.Nickname)
+		if l.Tag {
+			template = detector.spdxTemplate(l.Nickname)
+		}
+		if template == nil {
+			return nil
+		}
+		result.Licenses = append(result.Licenses, &License{
+			Score:        l.Score,
+			Template:     template,
+			Path:         filepath.Join(path, l.File),
+			ExtraWords:   l.ExtraWords,
+			MissingWords: l.MissingWords,
+			Tag:          l.Tag,
+		})
+	}
+	return &result
+}
+
+func toCacheEntry(module string, version string, path string, result *Result) *cacheEntry {
+	entry := cacheEntry{
+		Module:     module,
+		Version:    version,
+		Expression: result.Expression,
+		Choice:     result.Choice,
+		Tags:       result.Tags,
+		Copyrights: result.Copyrights,
+	}
+	for _, license := range result.Licenses {
+		cached := cacheLicense{
+			File:         filepath.Base(license.Path),
+			Nickname:     license.Template.Nickname,
+			Score:        license.Score,
+			ExtraWords:   license.ExtraWords,
+			MissingWords: license.MissingWords,
+			Tag:          license.Tag,
+		}
+		if license.Tag {
+			cached.File, _ = filepath.Rel(path, license.Path)
+			cached.Nickname = license.Template.SPDXID
+		}
+		entry.Licenses = append(entry.Licenses, cached)
+	}
+	return &entry
+}
+
+type licenseFile struct {
+	path string
+	data []byte
+}
+
+func readLicenseFiles(path string) ([]licenseFile, error) {
+	paths, err := findLicenseFiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []licenseFile{}
+	for _, p := range paths {
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, licenseFile{path: p, data: data})
+	}
+	return files, nil
+}
+
+func (detector *Detector) identify(path string, files []licenseFile) (*Result, error) {
+	result := Result{}
+	paths := []string{}
+	for _, file := range files {
+		result.Licenses = append(result.Licenses, detector.match(file.path, file.data))
+		paths = append(paths, file.path)
+	}
+
+	var tags []spdxTag
+	if len(files) == 0 || detector.checkTags {
+		var err error
+		tags, err = scanSPDXTags(path)
+		if err != nil {
+			return nil, &ReadError{Path: path, Err: err}
+		}
+		for _, tag := range tags {
+			result.Tags = append(result.Tags, tag.expression)
+		}
+	}
+
+	copyrights := newCopyrightSet()
+	for k, file := range files {
+		copyrights.ignoreTemplate(result.Licenses[k].Template)
+		copyrights.addText(strings.Split(string(file.data), "\n"))
+	}
+	if detector.sourceCopyrights {
+		if err := copyrights.scanSources(path); err != nil {
+			return nil, &ReadError{Path: path, Err: err}
+		}
+	}
+	result.Copyrights = copyrights.copyrights()
+
+	if len(files) > 0 {
+		result.Choice = isChoice(path, paths)
+		result.Expression = buildExpression(result.Licenses, result.Choice)
+		return &result, nil
+	}
+	if len(tags) == 0 {
+		return nil, &NoLicenseError{Path: path}
+	}
+
+	// Without license files the tags are all there is
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		for _, id := range expressionIDs(tag.expression) {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			result.Licenses = append(result.Licenses, &License{
+				Score:    detector.tagScore(id),
+				Template: detector.spdxTemplate(id),
+				Path:     tag.file,
+				Tag:      true,
+			})
+		}
+	}
+	result.Choice = len(tags) == 1 && isOrExpression(tags[0].expression)
+	result.Expression = tagsExpression(result.Tags)
+	return &result, nil
+}
+
+func (detector *Detector) match(licenseFile string, data []byte) *License {
+	license := License{
+		Path: licenseFile,
+	}
+
+	match := matchTemplates(data, detector.templates)
+
+	license.Score = match.Score
+	license.Template = match.Template
+	license.ExtraWords = match.ExtraWords
+	license.MissingWords = match.MissingWords
+
+	return &license
+}
@@ -0,0 +1,111 @@
+/*
+ * go-vendor-licenses - errors.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// NoLicenseError is returned for packages without license file. Tags holds
+// the SPDX-License-Identifier tags, if the package has any.
+type NoLicenseError struct {
+	Path string
+	Tags []string
+}
+
+func (e *NoLicenseError) Error() string {
+	if len(e.Tags) > 0 {
+		return fmt.Sprintf("Unable to identify license of %s: no license file found, only SPDX-License-Identifier %s",
+			e.Path, strings.Join(e.Tags, ", "))
+	}
+	return fmt.Sprintf("Unable to identify license of %s: no license file or SPDX-License-Identifier found", e.Path)
+}
+
+// ErrNoSource is the error of a ReadError for modules whose sources are not
+// available, so their licenses cannot be identified.
+var ErrNoSource = errors.New("no source directory")
+
+// ReadError is returned if the license files of a package cannot be read.
+type ReadError struct {
+	Path string
+	Err  error
+}
+
+func (e *ReadError) Error() string {
+	return fmt.Sprintf("Unable to identify license of %s: %s", e.Path, e.Err.Error())
+}
+
+func (e *ReadError) Unwrap() error {
+	return e.Err
+}
+
+// LowConfidenceError is reported for licenses which only resemble their
+// best matching template, so the match is not to be trusted.
+type LowConfidenceError struct {
+	Module  string
+	License *License
+}
+
+func (e *LowConfidenceError) Error() string {
+	return fmt.Sprintf("License of %s not identified without doubt: %s (%2d%%) [%s]",
+		e.Module, e.License.Template.Title, int(100*e.License.Score), filepath.Base(e.License.Path))
+}
+
+// CriticalLicenseError is returned by BuildLicense for copyleft or missing
+// licenses.
+type CriticalLicenseError struct {
+	License *License
+}
+
+func (e *CriticalLicenseError) Error() string {
+	return fmt.Sprintf("Found critical license: %s", e.License.Template.Nickname)
+}
+
+// DeniedError is reported for modules whose license is denied by the policy.
+type DeniedError struct {
+	Module    string
+	Nicknames []string
+}
+
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("License of %s is denied by the policy: %s",
+		e.Module, strings.Join(e.Nicknames, ", "))
+}
+
+// NoticeError is reported for modules whose license requires shipping their
+// NOTICE files, which cannot be read.
+type NoticeError struct {
+	Module string
+	Err    error
+}
+
+func (e *NoticeError) Error() string {
+	return fmt.Sprintf("Unable to read NOTICE of %s: %s", e.Module, e.Err.Error())
+}
+
+func (e *NoticeError) Unwrap() error {
+	return e.Err
+}
+
+// DisclaimerError is reported for modules whose disclaimer files cannot be
+// read.
+type DisclaimerError struct {
+	Module string
+	Err    error
+}
+
+func (e *DisclaimerError) Error() string {
+	return fmt.Sprintf("Unable to read disclaimer of %s: %s", e.Module, e.Err.Error())
+}
+
+func (e *DisclaimerError) Unwrap() error {
+	return e.Err
+}
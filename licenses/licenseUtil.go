@@ -15,13 +15,11 @@ import (
 	"bytes"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
-	"text/tabwriter"
 
 	"github.com/tq-systems/go-vendor-licenses/licenses/assets"
 )
@@ -31,38 +29,187 @@ var (
 		`((?:un)?licen[sc]e)|` +
 		`((?:un)?licen[sc]e\.(?:md|markdown|txt))|` +
 		`(copy(?:ing|right)(?:\.[^.]+)?)|` +
-		`(licen[sc]e\.[^.]+)` +
+		`(licen[sc]e\.[^.]+)|` +
+		`((?:un)?licen[sc]e[-_][^.]+(?:\.(?:md|markdown|txt))?)` +
 		`)$`)
 	regexDisclaim = regexp.MustCompile(`(?i)^(?:` +
 		`((?:un)?licen[sc]e[s]*)(?:\.[^.]+)?|` +
 		`(copy[(?:ing|right)]*(?:\.[^.]+)?)|` +
 		`(author[s]*)(?:\.[^.]+)?|` +
 		`(contributor[s]*)(?:\.[^.]+)?|` +
-		`(patent[s]*)(?:\.[^.]+)?` +
+		`(patent[s]*)(?:\.[^.]+)?|` +
+		`(notice)(?:\.(?:md|markdown|txt))?` +
 		`)$`)
-	regexWords     = regexp.MustCompile(`[\w']+`)
-	regexCopyright = regexp.MustCompile(
+	// regexSourceFile matches files named like licenses, but holding code,
+	// like license.go
+	regexSourceFile = regexp.MustCompile(`(?i)\.(?:go|s|c|h|cc|cpp|hpp|m|py|js|ts|java|rs|rb|pl|sh|proto|json|ya?ml|toml|mod|sum)$`)
+	regexNotice     = regexp.MustCompile(`(?i)^notice(?:\.(?:md|markdown|txt))?$`)
+	regexWords      = regexp.MustCompile(`[\w']+`)
+	regexCopyright  = regexp.MustCompile(
 		`(?i)\s*Copyright (?:©|\(c\)|\xC2\xA9)?\s*(?:\d{4}|\[year\]).*`)
 )
 
 var (
+	// criticalLicenseNicknames are the copyleft, non-commercial and missing
+	// licenses. They are denied by the default policy.
 	criticalLicenseNicknames = []string{
+		"AGPL-1.0-only",
+		"AGPL-1.0-or-later",
 		"AGPL-3.0",
+		"APSL-1.0",
+		"APSL-1.1",
+		"APSL-1.2",
+		"APSL-2.0",
+		"BUSL-1.1",
+		"CAL-1.0",
+		"CC-BY-NC-1.0",
+		"CC-BY-NC-2.0",
+		"CC-BY-NC-2.5",
+		"CC-BY-NC-3.0",
+		"CC-BY-NC-3.0-DE",
+		"CC-BY-NC-4.0",
+		"CC-BY-NC-ND-1.0",
+		"CC-BY-NC-ND-2.0",
+		"CC-BY-NC-ND-2.5",
+		"CC-BY-NC-ND-3.0",
+		"CC-BY-NC-ND-3.0-DE",
+		"CC-BY-NC-ND-3.0-IGO",
+		"CC-BY-NC-ND-4.0",
+		"CC-BY-NC-SA-1.0",
+		"CC-BY-NC-SA-2.0",
+		"CC-BY-NC-SA-2.0-DE",
+		"CC-BY-NC-SA-2.0-FR",
+		"CC-BY-NC-SA-2.0-UK",
+		"CC-BY-NC-SA-2.5",
+		"CC-BY-NC-SA-3.0",
+		"CC-BY-NC-SA-3.0-DE",
+		"CC-BY-NC-SA-3.0-IGO",
+		"CC-BY-NC-SA-4.0",
+		"CC-BY-SA-1.0",
+		"CC-BY-SA-2.0",
+		"CC-BY-SA-2.0-UK",
+		"CC-BY-SA-2.1-JP",
+		"CC-BY-SA-2.5",
+		"CC-BY-SA-3.0",
+		"CC-BY-SA-3.0-AT",
+		"CC-BY-SA-3.0-DE",
+		"CC-BY-SA-3.0-IGO",
+		"CC-BY-SA-4.0",
+		"CDDL-1.0",
+		"CDDL-1.1",
+		"CECILL-1.0",
+		"CECILL-1.1",
+		"CECILL-2.0",
+		"CECILL-2.1",
+		"CECILL-C",
+		"CERN-OHL-1.1",
+		"CERN-OHL-1.2",
+		"CERN-OHL-S-2.0",
+		"CERN-OHL-W-2.0",
+		"CPAL-1.0",
+		"CPL-1.0",
+		"copyleft-next-0.3.0",
+		"copyleft-next-0.3.1",
+		"D-FSL-1.0",
+		"Elastic-2.0",
 		"EPL-1.0",
+		"EPL-2.0",
+		"ErlPL-1.1",
+		"EUPL-1.0",
+		"EUPL-1.1",
+		"EUPL-1.2",
+		"GFDL-1.1-only",
+		"GFDL-1.2-only",
+		"GFDL-1.3-only",
+		"GPL-1.0-only",
+		"GPL-1.0-or-later",
 		"GPL-2.0",
 		"GPL-3.0",
+		"IPL-1.0",
+		"Interbase-1.0",
+		"JPL-image",
+		"LGPL-2.0-only",
+		"LGPL-2.0-or-later",
+		"LGPL-2.1",
+		"LGPL-3.0",
+		"LGPLLR",
+		"LiLiQ-R-1.1",
+		"LiLiQ-Rplus-1.1",
+		"LPL-1.0",
+		"LPL-1.02",
+		"Motosoto",
+		"MPL-1.0",
+		"MPL-1.1",
 		"MPL-2.0",
 		"MS-RL",
+		"NASA-1.3",
+		"NGPL",
+		"Nokia",
 		"NOLICENSE",
-		"LGPL-2.1",
+		"NPL-1.0",
+		"NPL-1.1",
+		"OCLC-2.0",
+		"ODbL-1.0",
+		"OSET-PL-2.1",
+		"OSL-1.0",
+		"OSL-1.1",
+		"OSL-2.0",
+		"OSL-2.1",
 		"OSL-3.0",
+		"Parity-6.0.0",
+		"Parity-7.0.0",
+		"PolyForm-Noncommercial-1.0.0",
+		"PolyForm-Small-Business-1.0.0",
+		"QPL-1.0",
+		"QPL-1.0-INRIA-2004",
+		"RPL-1.1",
+		"RPL-1.5",
+		"RPSL-1.0",
+		"SimPL-2.0",
+		"Sleepycat",
+		"SPL-1.0",
+		"SSPL-1.0",
+		"TAPR-OHL-1.0",
+		"Watcom-1.0",
 	}
 )
 
+// minConfidence is the score a license must reach to be considered as
+// identified without doubt.
+const minConfidence = 0.95
+
+// SPDXLicenseListVersion is the version of the SPDX license list, whose
+// licenses are embedded as templates.
+const SPDXLicenseListVersion = assets.SPDXLicenseListVersion
+
+// IsSPDXLicenseID reports whether id is on the SPDX license list. Other
+// identifiers, like LicenseRef-* or those of unknown SPDX-License-Identifier
+// tags, are not.
+func IsSPDXLicenseID(id string) bool {
+	k := sort.SearchStrings(assets.SPDXLicenseIDs, id)
+	return k < len(assets.SPDXLicenseIDs) && assets.SPDXLicenseIDs[k] == id
+}
+
 type Template struct {
 	Title    string
 	Nickname string
-	Words    map[string]int
+	SPDXID   string
+	// Required, Permitted and Forbidden are the conditions, permissions and
+	// limitations of the license, like include-copyright. They are all nil
+	// if the template does not state them.
+	Required  []string
+	Permitted []string
+	Forbidden []string
+	// Text is the license text of the template, which is empty for SPDX
+	// identifiers without template
+	Text  string
+	Words map[string]int
+	// alternatives are the word sets of other texts of the license, like
+	// the one of the SPDX license list
+	alternatives []map[string]int
+	// alternative is set for templates, which are an alternative text of
+	// the template with the same nickname
+	alternative bool
 }
 
 type License struct {
@@ -72,6 +219,9 @@ type License struct {
 	Err          string
 	ExtraWords   []string
 	MissingWords []string
+	// Tag is set if the license was taken from an SPDX-License-Identifier
+	// tag in the file at Path instead of a license file
+	Tag bool
 }
 
 type MatchResult struct {
@@ -112,25 +262,38 @@ func sortAndReturnWords(words []Word) []string {
 func matchTemplates(license []byte, templates []*Template) MatchResult {
 	bestScore := float64(-1)
 	var bestTemplate *Template
-	bestExtra := []Word{}
-	bestMissing := []Word{}
+	var bestWords map[string]int
 	words := makeWordSet(license)
 	for _, t := range templates {
-		extra := []Word{}
-		missing := []Word{}
-		common := 0
+		for _, templateWords := range append([]map[string]int{t.Words}, t.alternatives...) {
+			common := 0
+			for w := range words {
+				if _, ok := templateWords[w]; ok {
+					common++
+				}
+			}
+			score := 2 * float64(common) / (float64(len(words)) + float64(len(templateWords)))
+			if score > bestScore {
+				bestScore = score
+				bestTemplate = t
+				bestWords = templateWords
+			}
+		}
+	}
+
+	// Collecting the differing words is only worth it for the best match
+	extra := []Word{}
+	missing := []Word{}
+	if bestTemplate != nil {
 		for w, pos := range words {
-			_, ok := t.Words[w]
-			if ok {
-				common++
-			} else {
+			if _, ok := bestWords[w]; !ok {
 				extra = append(extra, Word{
 					Text: w,
 					Pos:  pos,
 				})
 			}
 		}
-		for w, pos := range t.Words {
+		for w, pos := range bestWords {
 			if _, ok := words[w]; !ok {
 				missing = append(missing, Word{
 					Text: w,
@@ -138,19 +301,12 @@ func matchTemplates(license []byte, templates []*Template) MatchResult {
 				})
 			}
 		}
-		score := 2 * float64(common) / (float64(len(words)) + float64(len(t.Words)))
-		if score > bestScore {
-			bestScore = score
-			bestTemplate = t
-			bestMissing = missing
-			bestExtra = extra
-		}
 	}
 	return MatchResult{
 		Template:     bestTemplate,
 		Score:        bestScore,
-		ExtraWords:   sortAndReturnWords(bestExtra),
-		MissingWords: sortAndReturnWords(bestMissing),
+		ExtraWords:   sortAndReturnWords(extra),
+		MissingWords: sortAndReturnWords(missing),
 	}
 }
 
@@ -180,6 +336,8 @@ func parseTemplate(content string) (*Template, error) {
 	t := Template{}
 	text := []byte{}
 	state := 0
+	// list is the list of the front matter the items are added to
+	var list *[]string
 	scanner := bufio.NewScanner(strings.NewReader(content))
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
@@ -190,11 +348,30 @@ func parseTemplate(content string) (*Template, error) {
 		} else if state == 1 {
 			if line == "---" {
 				state = 2
-			} else {
+			} else if strings.HasPrefix(line, "- ") {
+				if list != nil {
+					*list = append(*list, strings.TrimSpace(line[len("- "):]))
+				}
+			} else if line != "" {
+				list = nil
 				if strings.HasPrefix(line, "title:") {
 					t.Title = strings.TrimSpace(line[len("title:"):])
 				} else if strings.HasPrefix(line, "nickname:") {
 					t.Nickname = strings.TrimSpace(line[len("nickname:"):])
+				} else if strings.HasPrefix(line, "spdx-id:") {
+					t.SPDXID = strings.TrimSpace(line[len("spdx-id:"):])
+				} else if strings.HasPrefix(line, "alternative:") {
+					t.alternative = strings.TrimSpace(line[len("alternative:"):]) == "true"
+				} else if strings.HasPrefix(line, "required:") {
+					list = &t.Required
+				} else if strings.HasPrefix(line, "permitted:") {
+					list = &t.Permitted
+				} else if strings.HasPrefix(line, "forbidden:") {
+					list = &t.Forbidden
+				}
+				// Lists may be empty, but are stated nonetheless
+				if list != nil && *list == nil {
+					*list = []string{}
 				}
 			}
 		} else if state == 2 {
@@ -202,19 +379,55 @@ func parseTemplate(content string) (*Template, error) {
 			text = append(text, []byte("\n")...)
 		}
 	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if state == 0 {
+		return nil, fmt.Errorf("no front matter")
+	} else if state == 1 {
+		return nil, fmt.Errorf("front matter not terminated by ---")
+	}
+	t.Text = strings.TrimSpace(string(text))
 	t.Words = makeWordSet(text)
-	return &t, scanner.Err()
+	return &t, nil
 }
 
 func loadTemplates() ([]*Template, error) {
 	templates := []*Template{}
+	// Alternatives are kept in asset order, so the order of the texts of a
+	// template and thus the tie-breaking between them is deterministic
+	alternatives := []*Template{}
+	alternativeNames := []string{}
 	for _, a := range assets.Assets {
 		templ, err := parseTemplate(a.Content)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("Invalid template %s: %s", a.Name, err.Error())
+		}
+		if templ.alternative {
+			alternatives = append(alternatives, templ)
+			alternativeNames = append(alternativeNames, a.Name)
+			continue
+		}
+		// Templates whose nickname differs from the SPDX identifier state it
+		// in their front matter
+		if templ.SPDXID == "" {
+			templ.SPDXID = templ.Nickname
 		}
 		templates = append(templates, templ)
 	}
+	for i, alt := range alternatives {
+		found := false
+		for _, templ := range templates {
+			if templ.Nickname == alt.Nickname {
+				templ.alternatives = append(templ.alternatives, alt.Words)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("Invalid template %s: no template %s", alternativeNames[i], alt.Nickname)
+		}
+	}
 	return templates, nil
 }
 
@@ -226,7 +439,7 @@ func matchDisclaimName(name string) bool {
 func scoreLicenseName(name string) float64 {
 	m := regexLicense.FindStringSubmatch(name)
 	switch {
-	case m == nil:
+	case m == nil, regexSourceFile.MatchString(name):
 		break
 	case m[1] != "":
 		return 1.0
@@ -236,127 +449,154 @@ func scoreLicenseName(name string) float64 {
 		return 0.8
 	case m[4] != "":
 		return 0.7
+	case m[5] != "":
+		return 0.6
 	}
 	return 0.0
 }
 
-func findLicenseFile(path string) (string, error) {
+// isSuffixedLicenseName reports whether the file name names one of several
+// licenses, like LICENSE-MIT.
+func isSuffixedLicenseName(name string) bool {
+	m := regexLicense.FindStringSubmatch(name)
+	return m != nil && m[5] != ""
+}
+
+// findLicenseFiles returns all license files in path, the most likely ones
+// first.
+func findLicenseFiles(path string) ([]string, error) {
 	files, err := ioutil.ReadDir(path)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	bestScore := float64(0)
-	bestName := ""
+	type candidate struct {
+		name  string
+		score float64
+	}
+	candidates := []candidate{}
 	for _, file := range files {
 		if file.IsDir() {
 			continue
 		}
 		score := scoreLicenseName(file.Name())
-		if score > bestScore {
-			bestScore = score
-			bestName = file.Name()
+		if score > 0 {
+			candidates = append(candidates, candidate{file.Name(), score})
 		}
 	}
-	if bestName != "" {
-		return filepath.Join(path, bestName), nil
-	}
-	return "", nil
-}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score > candidates[j].score
+	})
 
-func identifyLicense(path string) (*License, error) {
-	templates, err := loadTemplates()
-	if err != nil {
-		return nil, err
-	}
-
-	licenseFile, err := findLicenseFile(path)
-	license := License{
-		Path: licenseFile,
-	}
-
-	data, err := ioutil.ReadFile(licenseFile)
-	if err != nil {
-		return nil, err
+	licenseFiles := []string{}
+	for _, c := range candidates {
+		licenseFiles = append(licenseFiles, filepath.Join(path, c.name))
 	}
-
-	match := matchTemplates(data, templates)
-
-	license.Score = match.Score
-	license.Template = match.Template
-	license.ExtraWords = match.ExtraWords
-	license.MissingWords = match.MissingWords
-
-	return &license, nil
+	return licenseFiles, nil
 }
 
-func BuildLicenseString(path string) (string, error) {
-	confidence := 0.95
-
-	license, err := identifyLicense(path)
-	if err != nil {
-		return "", fmt.Errorf("Unable to identify license of %s: %s", path, err.Error())
+// IsCritical reports whether the license matched one of the copyleft or
+// missing license templates.
+func (license *License) IsCritical() bool {
+	if license.Template == nil {
+		return false
 	}
-
 	for _, match := range criticalLicenseNicknames {
 		if match == license.Template.Nickname {
-			log.Println("Found critical license: ", license.Template.Nickname)
-			err = fmt.Errorf("criticalLicense")
+			return true
 		}
 	}
+	return false
+}
 
-	licenseString := "?"
+// IsConfident reports whether the license was identified with a score high
+// enough to trust the match.
+func (license *License) IsConfident() bool {
+	return license.Template != nil && license.Score >= minConfidence
+}
+
+// String returns the human readable description of the license as used in
+// the manifest.
+func (license *License) String() string {
+	return license.summary() + license.wordLines()
+}
+
+// summary describes the license in a single line.
+func (license *License) summary() string {
 	if license.Template != nil {
-		if license.Score >= confidence {
-			licenseString = fmt.Sprintf("%s (%2d%%)",
-				license.Template.Title, int(100*license.Score))
-		} else {
-			licenseString = fmt.Sprintf("%s (%2d%%)",
-				license.Template.Title, int(100*license.Score))
-			if len(license.ExtraWords) > 0 {
-				licenseString += "\n\t+words: " + strings.Join(license.ExtraWords, ", ")
-			}
-			if len(license.MissingWords) > 0 {
-				licenseString += "\n\t-words: " + strings.Join(license.MissingWords, ", ")
-			}
-		}
+		return fmt.Sprintf("%s (%2d%%)", license.Template.Title, int(100*license.Score))
 	} else if license.Err != "" {
-		licenseString = strings.Replace(license.Err, "\n", " ", -1)
+		return strings.Replace(license.Err, "\n", " ", -1)
 	}
-	return licenseString, err
+	return "?"
 }
 
-func BuildDisclaimerString(path string, pkg string) error {
-	var disclaimer string = ""
-	writer := tabwriter.NewWriter(os.Stdout, 1, 4, 2, ' ', 0)
-
-	disclaimer += fmt.Sprintf("\nDISCLAIMER of %s:\n", pkg)
+// wordLines lists the words which differ from the template, if the license
+// is not identified without doubt.
+func (license *License) wordLines() string {
+	if license.Template == nil || license.IsConfident() {
+		return ""
+	}
+	lines := ""
+	if len(license.ExtraWords) > 0 {
+		lines += "\n\t+words: " + strings.Join(license.ExtraWords, ", ")
+	}
+	if len(license.MissingWords) > 0 {
+		lines += "\n\t-words: " + strings.Join(license.MissingWords, ", ")
+	}
+	return lines
+}
 
-	files, err := ioutil.ReadDir(filepath.Join(path))
+// IdentifyLicense identifies the license of the most likely license file of
+// the package in path. Use a Detector to identify all licenses of many
+// packages.
+func IdentifyLicense(path string) (*License, error) {
+	detector, err := defaultDetector()
 	if err != nil {
-		return err
+		return nil, err
 	}
+	result, err := detector.Identify(path)
+	if err != nil {
+		return nil, err
+	}
+	if result.Best() == nil || result.Best().Tag {
+		return nil, &NoLicenseError{Path: path, Tags: result.Tags}
+	}
+	return result.Best(), nil
+}
 
-	for _, file := range files {
-		if !file.Mode().IsRegular() {
-			continue
-		}
+// BuildLicense identifies the license of the package in path. If the license
+// is critical, the license is returned together with a CriticalLicenseError.
+func BuildLicense(path string) (*License, error) {
+	license, err := IdentifyLicense(path)
+	if err != nil {
+		return nil, err
+	}
 
-		if matchDisclaimName(file.Name()) {
-			disclaimer += fmt.Sprintf("\nFilename: %s\n", filepath.Base(file.Name()))
-			content, err := ioutil.ReadFile(filepath.Join(path, file.Name()))
-			if err != nil {
-				log.Println(err)
-			}
-			disclaimer += fmt.Sprintf("%s", content)
-		}
+	if license.IsCritical() {
+		err = &CriticalLicenseError{License: license}
 	}
+	return license, err
+}
 
-	_, err2 := writer.Write([]byte(disclaimer + "\n"))
-	if err2 != nil {
-		return err2
+func BuildLicenseString(path string) (string, error) {
+	license, err := BuildLicense(path)
+	if license == nil {
+		return "", err
 	}
+	return license.String(), err
+}
 
-	writer.Flush()
-	return nil
+// BuildDisclaimerString writes the disclaimer files of the package in path
+// to stdout. Files which cannot be read are skipped and their error returned.
+// Use ReadDisclaimer and WriteDisclaimer to write elsewhere.
+func BuildDisclaimerString(path string, pkg string) error {
+	files, readErr := ReadDisclaimer(path)
+	if files == nil {
+		return readErr
+	}
+	if err := WriteDisclaimer(os.Stdout, pkg, files); err != nil {
+		return err
+	}
+	return readErr
 }
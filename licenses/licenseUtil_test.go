@@ -0,0 +1,166 @@
+/*
+ * go-vendor-licenses - licenseUtil_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestScoreLicenseName(t *testing.T) {
+	tests := []struct {
+		name  string
+		score float64
+	}{
+		{"LICENSE", 1.0},
+		{"unlicense", 1.0},
+		{"LICENSE.md", 0.9},
+		{"COPYING", 0.8},
+		{"COPYRIGHT.txt", 0.8},
+		{"LICENSE.BSD", 0.7},
+		{"LICENSE-MIT", 0.6},
+		{"LICENSE_APACHE.txt", 0.6},
+		{"license.go", 0},
+		{"copyright.go", 0},
+		{"License.c", 0},
+		{"LICENSE.json", 0},
+		{"README.md", 0},
+	}
+	for _, test := range tests {
+		if score := scoreLicenseName(test.name); score != test.score {
+			t.Errorf("scoreLicenseName(%q) = %v, want %v", test.name, score, test.score)
+		}
+	}
+}
+
+// writeModule creates a module directory with the files.
+func writeModule(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		file := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestIdentifyIgnoresSourcesNamedLikeLicenses(t *testing.T) {
+	detector, err := NewDetector()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := writeModule(t, map[string]string{
+		"LICENSE":      detector.Template("BSD-2-Clause").Text,
+		"license.go":   "package example\n\n// License returns the license of the module.\nfunc License() string { return \"\" }\n",
+		"copyright.go": "package example\n",
+	})
+
+	result, err := detector.Identify(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Licenses) != 1 {
+		t.Fatalf("identified %d licenses, want 1: %s", len(result.Licenses), result)
+	}
+	license := result.Licenses[0]
+	if license.Template.Nickname != "BSD-2-Clause" || !license.IsConfident() {
+		t.Errorf("identified %s, want BSD-2-Clause", license)
+	}
+	if filepath.Base(license.Path) != "LICENSE" {
+		t.Errorf("license file %s, want LICENSE", license.Path)
+	}
+}
+
+func TestResultStringSuffixesFileToLicenseLine(t *testing.T) {
+	template := &Template{Title: "MIT License", Nickname: "MIT"}
+	result := Result{Licenses: []*License{
+		{Template: template, Score: 1, Path: "/m/LICENSE-MIT"},
+		{Template: template, Score: 0.5, Path: "/m/LICENSE-OTHER", ExtraWords: []string{"foo"}},
+	}}
+	want := "MIT License (100%) [LICENSE-MIT]\n\tMIT License (50%) [LICENSE-OTHER]\n\t+words: foo"
+	if s := result.String(); s != want {
+		t.Errorf("String() = %q, want %q", s, want)
+	}
+}
+
+func TestIdentifySPDXLicenseList(t *testing.T) {
+	detector, err := NewDetector()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		text     string
+		nickname string
+		spdxID   string
+	}{
+		{"EUPL-1.2", "EUPL-1.2", "EUPL-1.2"},
+		{"Zlib", "Zlib", "Zlib"},
+		{"BSL-1.0", "BSL-1.0", "BSL-1.0"},
+		{"MIT-0", "MIT-0", "MIT-0"},
+		{"CDDL-1.0", "CDDL-1.0", "CDDL-1.0"},
+		// Texts of licenses with a template of choosealicense
+		{"GPL-2.0-only", "GPL-2.0", "GPL-2.0-only"},
+		{"MPL-2.0", "MPL-2.0", "MPL-2.0"},
+		// The text does not tell whether later versions may be used
+		{"GPL-3.0-or-later", "GPL-3.0", "GPL-3.0-only"},
+	}
+	for _, test := range tests {
+		text, err := ioutil.ReadFile(filepath.Join("assets", "spdx", "text", test.text+".txt"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := detector.Identify(writeModule(t, map[string]string{"LICENSE": string(text)}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Licenses) != 1 {
+			t.Errorf("%s: identified %d licenses, want 1", test.text, len(result.Licenses))
+			continue
+		}
+		license := result.Licenses[0]
+		if license.Template.Nickname != test.nickname || license.Template.SPDXID != test.spdxID || !license.IsConfident() {
+			t.Errorf("%s: identified %s (%s), want %s (%s)", test.text, license, license.Template.SPDXID, test.nickname, test.spdxID)
+		}
+	}
+}
+
+func TestLoadTemplatesMergesAlternatives(t *testing.T) {
+	templates, err := loadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	nicknames := map[string]bool{}
+	for _, template := range templates {
+		if nicknames[template.Nickname] {
+			t.Errorf("template %s loaded twice", template.Nickname)
+		}
+		nicknames[template.Nickname] = true
+		if template.Nickname == "GPL-2.0" && len(template.alternatives) != 1 {
+			t.Errorf("template GPL-2.0 has %d alternatives, want 1", len(template.alternatives))
+		}
+	}
+
+	// Loading is deterministic, including the order of the alternatives
+	again, err := loadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, template := range templates {
+		if again[i].Nickname != template.Nickname || !reflect.DeepEqual(again[i].alternatives, template.alternatives) {
+			t.Errorf("template %d loaded as %s, then as %s", i, template.Nickname, again[i].Nickname)
+		}
+	}
+}
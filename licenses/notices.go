@@ -0,0 +1,181 @@
+/*
+ * go-vendor-licenses - notices.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// regexCopyrightLine matches lines stating a copyright, but not the
+	// license texts referring to copyright notices
+	regexCopyrightLine = regexp.MustCompile(`(?i)^[\s#*/]*(?:` +
+		`copyright\s*(?:\(c\)|©)?\s*(?:\d{4}|\(c\)|©)|` +
+		`(?:\(c\)|©)\s*\d{4})`)
+	// noticesSeparator separates the license texts in the notices
+	noticesSeparator = strings.Repeat("=", 80)
+)
+
+// Notice is a license text shared by several modules. The copyrights are
+// kept per module.
+type Notice struct {
+	Title   string
+	Text    string
+	Modules []NoticeModule
+}
+
+// NoticeModule is a module using the license text of a notice.
+type NoticeModule struct {
+	Name       string
+	Version    string
+	Copyrights []string
+	// Notices are the NOTICE files of the module, which are shipped once
+	// after all license texts
+	Notices []DisclaimerFile
+}
+
+// Notices groups the identified licenses of all dependencies by their
+// license text, so every text is only shipped once. Texts are compared
+// without copyright lines, case and whitespace. Modules licensed by
+// SPDX-License-Identifier tags only use the text of the template. Modules
+// without identified license are left out.
+func (report *Report) Notices() ([]Notice, error) {
+	notices := []*Notice{}
+	byText := map[string]*Notice{}
+	for _, module := range report.Modules {
+		if module.Main || module.Result == nil {
+			continue
+		}
+		version := module.Version
+		if version == "" {
+			version = module.Revision
+		}
+
+		copyrights := []string{}
+		for _, copyright := range module.Result.Copyrights {
+			copyrights = append(copyrights, copyright.String())
+		}
+
+		for _, license := range module.Result.Licenses {
+			title, text, err := noticeText(license)
+			if err != nil {
+				return nil, err
+			}
+			key := normalizeNoticeText(text)
+			notice, ok := byText[key]
+			if !ok {
+				notice = &Notice{Title: title, Text: text}
+				byText[key] = notice
+				notices = append(notices, notice)
+			}
+			if n := len(notice.Modules); n > 0 && notice.Modules[n-1].Name == module.Name {
+				continue
+			}
+			notice.Modules = append(notice.Modules, NoticeModule{
+				Name:       module.Name,
+				Version:    version,
+				Copyrights: copyrights,
+				Notices:    module.Notices,
+			})
+		}
+	}
+
+	sort.SliceStable(notices, func(i, j int) bool {
+		return notices[i].Title < notices[j].Title
+	})
+	ret := []Notice{}
+	for _, notice := range notices {
+		ret = append(ret, *notice)
+	}
+	return ret, nil
+}
+
+// noticeText returns the title and the text of the license without the
+// copyright lines, which are listed per module.
+func noticeText(license *License) (string, string, error) {
+	title := "Unidentified license"
+	if license.IsConfident() {
+		title = license.Template.Title
+	}
+	if license.Tag {
+		if license.Template.Text == "" {
+			return title, "SPDX-License-Identifier: " + license.Template.SPDXID, nil
+		}
+		text := stripCopyrights(license.Template.Text)
+		return title, text, nil
+	}
+
+	data, err := ioutil.ReadFile(license.Path)
+	if err != nil {
+		return "", "", &ReadError{Path: license.Path, Err: err}
+	}
+	text := stripCopyrights(string(data))
+	return title, text, nil
+}
+
+// stripCopyrights removes the copyright lines from the text.
+func stripCopyrights(data string) string {
+	lines := []string{}
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if !regexCopyrightLine.MatchString(line) {
+			lines = append(lines, line)
+		}
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+func normalizeNoticeText(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+// WriteNotices writes the notices as plain text, as it is shipped with the
+// software.
+func WriteNotices(w io.Writer, notices []Notice) error {
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "THIRD PARTY NOTICES\n\n")
+	fmt.Fprintf(out, "This software includes the following third party modules under the\n")
+	fmt.Fprintf(out, "licenses stated below.\n")
+
+	for _, notice := range notices {
+		fmt.Fprintf(out, "\n%s\n%s\n\nUsed by:\n", noticesSeparator, notice.Title)
+		for _, module := range notice.Modules {
+			if module.Version != "" {
+				fmt.Fprintf(out, "  %s %s\n", module.Name, module.Version)
+			} else {
+				fmt.Fprintf(out, "  %s\n", module.Name)
+			}
+			for _, copyright := range module.Copyrights {
+				fmt.Fprintf(out, "    %s\n", copyright)
+			}
+		}
+		fmt.Fprintf(out, "\n%s\n", notice.Text)
+	}
+
+	// Modules under several licenses are listed more than once
+	seen := map[string]bool{}
+	for _, notice := range notices {
+		for _, module := range notice.Modules {
+			if len(module.Notices) == 0 || seen[module.Name] {
+				continue
+			}
+			seen[module.Name] = true
+			for _, file := range module.Notices {
+				fmt.Fprintf(out, "\n%s\n%s of %s\n\n%s\n", noticesSeparator, file.Name,
+					module.Name, strings.TrimSpace(string(file.Content)))
+			}
+		}
+	}
+	return out.Flush()
+}
@@ -0,0 +1,83 @@
+/*
+ * go-vendor-licenses - notices_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"bytes"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNoticesGroupsLicenseTexts(t *testing.T) {
+	mit := &Template{Title: "MIT License", Nickname: "MIT", SPDXID: "MIT"}
+	bsd := &Template{Title: "BSD 3-clause License", Nickname: "BSD-3-Clause", SPDXID: "BSD-3-Clause"}
+	dir := writeModule(t, map[string]string{
+		"a/LICENSE": "Copyright (c) 2016 A Authors\n\nPermission is hereby granted,\nfree of charge.\n",
+		"b/LICENSE": "Copyright (c) 2020 B Authors\n\nPERMISSION is hereby  granted, free of charge.\r\n",
+		"c/LICENSE": "Copyright (c) 2018 C Authors\n\nRedistribution and use are permitted.\n",
+	})
+	license := func(module string, t *Template) *Result {
+		return &Result{
+			Licenses:   []*License{{Template: t, Score: 1, Path: filepath.Join(dir, module, "LICENSE")}},
+			Copyrights: []Copyright{{Years: "2016", Holder: strings.ToUpper(module) + " Authors"}},
+		}
+	}
+	report := &Report{Modules: []*ModuleReport{
+		{Module: Module{Name: "example.com/main", Main: true}, Result: license("a", mit)},
+		{Module: Module{Name: "example.com/c", Version: "v1.0.0"}, Result: license("c", bsd)},
+		{Module: Module{Name: "example.com/a", Version: "v1.0.0"}, Result: license("a", mit)},
+		{Module: Module{Name: "example.com/b", Revision: "abcdef"}, Result: license("b", mit)},
+		{Module: Module{Name: "example.com/unidentified"}},
+	}}
+
+	notices, err := report.Notices()
+	if err != nil {
+		t.Fatal(err)
+	}
+	titles := []string{}
+	for _, notice := range notices {
+		modules := []string{}
+		for _, module := range notice.Modules {
+			modules = append(modules, module.Name+" "+module.Version)
+		}
+		titles = append(titles, notice.Title+": "+strings.Join(modules, ", "))
+		if strings.Contains(notice.Text, "Copyright") {
+			t.Errorf("copyright in notice text %q", notice.Text)
+		}
+	}
+	want := []string{
+		"BSD 3-clause License: example.com/c v1.0.0",
+		"MIT License: example.com/a v1.0.0, example.com/b abcdef",
+	}
+	if !reflect.DeepEqual(titles, want) {
+		t.Errorf("notices %q, want %q", titles, want)
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := WriteNotices(buffer, notices); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(buffer.String(), "Permission is hereby granted"); n != 1 {
+		t.Errorf("MIT text written %d times, want once", n)
+	}
+	if !strings.Contains(buffer.String(), "    Copyright (c) 2016 B Authors\n") {
+		t.Errorf("copyright of example.com/b missing:\n%s", buffer)
+	}
+}
+
+func TestStripCopyrights(t *testing.T) {
+	text := "Copyright (c) 2016 Example Authors\n" +
+		"  Copyright 2017 Other Authors. All rights reserved.\n" +
+		"\n" +
+		"The above copyright notice shall be included.   \n"
+	if stripped := stripCopyrights(text); stripped != "The above copyright notice shall be included." {
+		t.Errorf("stripped text %q", stripped)
+	}
+}
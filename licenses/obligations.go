@@ -0,0 +1,230 @@
+/*
+ * go-vendor-licenses - obligations.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"sort"
+)
+
+// ObligationUnknown is reported for modules whose licenses do not state
+// their conditions, like those of the SPDX license list. They have to be
+// reviewed manually.
+const ObligationUnknown = "unknown"
+
+// conditionDescription describes a condition of the templates.
+type conditionDescription struct {
+	name        string
+	description string
+}
+
+// obligationDescriptions describes the required conditions of the templates,
+// in the order they are reported.
+var obligationDescriptions = []conditionDescription{
+	{"include-copyright", "Include the copyright notice and the license text with the software"},
+	{"document-changes", "State the changes made to the licensed code"},
+	{"disclose-source", "Make the source code available when distributing the software"},
+	{"network-use-disclose", "Make the source code available to users interacting with the software over a network"},
+	{"library-usage", "Allow replacing the library by a modified version when linking it with software under another license"},
+}
+
+// limitationDescriptions describes the permissions a license may lack and the
+// forbidden conditions of the templates, in the order they are reported. The
+// permissions come first.
+var limitationDescriptions = []conditionDescription{
+	{"distribution", "Distributing the software is not permitted"},
+	{"modifications", "Modifying the licensed code is not permitted"},
+	{"sublicense", "Sublicensing the licensed code is not permitted"},
+	{"commercial-use", "Commercial use of the software is not permitted"},
+	{"private-use", "Private use of the software is not permitted"},
+	{"no-sublicense", "The licensed code may not be sublicensed under other terms"},
+	{"trademark-use", "The trademarks of the licensors may not be used"},
+	{"no-liability", "The licensors are not liable for damages caused by the software"},
+}
+
+// permissions is the number of permissions at the start of
+// limitationDescriptions, which licenses with known conditions lack unless
+// they permit them.
+const permissions = 5
+
+// Obligation is a condition which has to be met when shipping software
+// with the modules, like include-copyright. Forbidden is set for what must
+// not be done with the modules, like distribution.
+type Obligation struct {
+	Name        string
+	Description string
+	Modules     []string
+	Forbidden   bool
+}
+
+// appliedLicenses returns the licenses of the result whose conditions apply.
+// If the result offers a choice, that is the license with the fewest required
+// and then forbidden conditions. ok is false if the conditions are not known.
+func (result *Result) appliedLicenses() (applied []*License, ok bool) {
+	if result == nil || len(result.Licenses) == 0 {
+		return nil, false
+	}
+
+	if result.Choice {
+		for _, license := range result.Licenses {
+			t := license.Template
+			if !license.IsConfident() || !hasConditions(t) {
+				continue
+			}
+			if !ok || fewerConditions(t, applied[0].Template) {
+				applied = []*License{license}
+				ok = true
+			}
+		}
+		return applied, ok
+	}
+
+	for _, license := range result.Licenses {
+		if !license.IsConfident() || !hasConditions(license.Template) {
+			return nil, false
+		}
+	}
+	return result.Licenses, true
+}
+
+// Obligations returns the conditions of the licenses of the result. If the
+// result offers a choice, those of the license with the fewest conditions
+// are returned. ok is false if the conditions are not known.
+func (result *Result) Obligations() (required []string, ok bool) {
+	applied, ok := result.appliedLicenses()
+	seen := map[string]bool{}
+	for _, license := range applied {
+		for _, name := range license.Template.Required {
+			if !seen[name] {
+				seen[name] = true
+				required = append(required, name)
+			}
+		}
+	}
+	return required, ok
+}
+
+// Limitations returns what must not be done with the licensed code: the
+// forbidden conditions of the licenses of the result and the permissions of
+// limitationDescriptions the licenses do not grant. A choice is treated like
+// by Obligations. ok is false if the conditions are not known.
+func (result *Result) Limitations() (forbidden []string, ok bool) {
+	applied, ok := result.appliedLicenses()
+	seen := map[string]bool{}
+	for _, license := range applied {
+		for _, name := range limitations(license.Template) {
+			if !seen[name] {
+				seen[name] = true
+				forbidden = append(forbidden, name)
+			}
+		}
+	}
+	return forbidden, ok
+}
+
+// limitations returns the forbidden conditions of the template and the
+// permissions it does not grant.
+func limitations(t *Template) []string {
+	names := append([]string{}, t.Forbidden...)
+	for _, d := range limitationDescriptions[:permissions] {
+		if !contains(t.Permitted, d.name) && !contains(t.Forbidden, d.name) {
+			names = append(names, d.name)
+		}
+	}
+	return names
+}
+
+// fewerConditions reports whether the template t requires less than the
+// other one, or as much but forbids less.
+func fewerConditions(t, other *Template) bool {
+	if len(t.Required) != len(other.Required) {
+		return len(t.Required) < len(other.Required)
+	}
+	return len(limitations(t)) < len(limitations(other))
+}
+
+func contains(list []string, name string) bool {
+	for _, item := range list {
+		if item == name {
+			return true
+		}
+	}
+	return false
+}
+
+func hasConditions(t *Template) bool {
+	return t != nil && (t.Required != nil || t.Permitted != nil || t.Forbidden != nil)
+}
+
+// AggregateObligations collects the obligations of the results of all
+// modules, which are given in the same order. The required conditions come
+// first, followed by the forbidden ones. Modules whose conditions are not
+// known are reported with ObligationUnknown, which comes last.
+func AggregateObligations(modules []string, results []*Result) []Obligation {
+	byName := map[string]*Obligation{}
+	forbiddenByName := map[string]*Obligation{}
+	add := func(byName map[string]*Obligation, name string, module string, forbidden bool) {
+		obligation, found := byName[name]
+		if !found {
+			obligation = &Obligation{Name: name, Description: name, Forbidden: forbidden}
+			byName[name] = obligation
+		}
+		if n := len(obligation.Modules); n == 0 || obligation.Modules[n-1] != module {
+			obligation.Modules = append(obligation.Modules, module)
+		}
+	}
+	for k, module := range modules {
+		required, ok := results[k].Obligations()
+		if !ok {
+			required = []string{ObligationUnknown}
+		}
+		for _, name := range required {
+			add(byName, name, module, false)
+		}
+		forbidden, _ := results[k].Limitations()
+		for _, name := range forbidden {
+			add(forbiddenByName, name, module, true)
+		}
+	}
+
+	unknown, hasUnknown := byName[ObligationUnknown]
+	delete(byName, ObligationUnknown)
+	obligations := sortObligations(byName, obligationDescriptions)
+	obligations = append(obligations, sortObligations(forbiddenByName, limitationDescriptions)...)
+
+	if hasUnknown {
+		unknown.Description = "Review the license, its conditions are not known"
+		obligations = append(obligations, *unknown)
+	}
+	return obligations
+}
+
+// sortObligations returns the obligations in the order of the descriptions,
+// followed by the others sorted by name. Conditions of user-supplied
+// templates are taken as they are.
+func sortObligations(byName map[string]*Obligation, descriptions []conditionDescription) []Obligation {
+	obligations := []Obligation{}
+	described := map[string]bool{}
+	for _, d := range descriptions {
+		if obligation, ok := byName[d.name]; ok {
+			obligation.Description = d.description
+			obligations = append(obligations, *obligation)
+			described[d.name] = true
+		}
+	}
+
+	others := []Obligation{}
+	for name, obligation := range byName {
+		if !described[name] {
+			others = append(others, *obligation)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool {
+		return others[i].Name < others[j].Name
+	})
+	return append(obligations, others...)
+}
@@ -0,0 +1,84 @@
+/*
+ * go-vendor-licenses - obligations_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAggregateObligationsForbidden(t *testing.T) {
+	detector, err := NewDetector()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := func(nicknames ...string) *Result {
+		result := &Result{}
+		for _, nickname := range nicknames {
+			result.Licenses = append(result.Licenses, &License{Template: detector.Template(nickname), Score: 1})
+		}
+		return result
+	}
+	modules := []string{"example.com/tq", "example.com/none", "example.com/mit", "example.com/unknown"}
+	results := []*Result{result("TQSSLA-1.0.2"), result("NOLICENSE"), result("MIT"), result("Zlib")}
+
+	obligations := AggregateObligations(modules, results)
+	got := map[string][]string{}
+	forbidden := map[string]bool{}
+	names := []string{}
+	for _, obligation := range obligations {
+		got[obligation.Name] = obligation.Modules
+		forbidden[obligation.Name] = obligation.Forbidden
+		names = append(names, obligation.Name)
+	}
+
+	tests := []struct {
+		name      string
+		modules   []string
+		forbidden bool
+	}{
+		{"include-copyright", []string{"example.com/none", "example.com/mit"}, false},
+		{"TQ-Systems hardware", []string{"example.com/tq"}, false},
+		{"distribution", []string{"example.com/tq", "example.com/none"}, true},
+		{"modifications", []string{"example.com/tq", "example.com/none"}, true},
+		{"sublicense", []string{"example.com/tq", "example.com/none"}, true},
+		{"no-liability", []string{"example.com/mit"}, true},
+		{ObligationUnknown, []string{"example.com/unknown"}, false},
+	}
+	for _, test := range tests {
+		if !reflect.DeepEqual(got[test.name], test.modules) || forbidden[test.name] != test.forbidden {
+			t.Errorf("%s: modules %v (forbidden %v), want %v (forbidden %v)",
+				test.name, got[test.name], forbidden[test.name], test.modules, test.forbidden)
+		}
+	}
+	if _, found := got["commercial-use"]; found {
+		t.Errorf("commercial-use reported as forbidden, but all licenses permit it")
+	}
+
+	want := []string{"include-copyright", "TQ-Systems hardware", "distribution", "modifications",
+		"sublicense", "no-liability", ObligationUnknown}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("order %q, want %q", names, want)
+	}
+}
+
+func TestLimitationsOfChoice(t *testing.T) {
+	detector, err := NewDetector()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := &Result{Choice: true, Licenses: []*License{
+		{Template: detector.Template("TQSSLA-1.0.2"), Score: 1},
+		{Template: detector.Template("NOLICENSE"), Score: 1},
+	}}
+	// Both require one condition, but TQSSLA forbids less
+	forbidden, ok := result.Limitations()
+	if want := []string{"distribution", "modifications", "sublicense"}; !ok || !reflect.DeepEqual(forbidden, want) {
+		t.Errorf("limitations %v, %v, want %v", forbidden, ok, want)
+	}
+}
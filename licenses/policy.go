@@ -0,0 +1,301 @@
+/*
+ * go-vendor-licenses - policy.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+)
+
+// Verdict is the assessment of a license by a policy.
+type Verdict string
+
+const (
+	VerdictAllow  Verdict = "allow"
+	VerdictReview Verdict = "needs-review"
+	VerdictDeny   Verdict = "deny"
+	// VerdictException is given to modules whose license would not be
+	// allowed, but which are approved by an exception of the policy.
+	VerdictException Verdict = "approved by exception"
+)
+
+// exceptionDateLayout is the format of the expiry date of exceptions.
+const exceptionDateLayout = "2006-01-02"
+
+// Policy assigns verdicts to licenses. The lists contain template nicknames
+// or SPDX identifiers, licenses not listed get the default verdict.
+type Policy struct {
+	Default    Verdict     `json:"default"`
+	Allow      []string    `json:"allow"`
+	Review     []string    `json:"needs-review"`
+	Deny       []string    `json:"deny"`
+	Exceptions []Exception `json:"exceptions"`
+}
+
+// Exception approves a module regardless of its license. Versions is an
+// optional list of constraints like ">=v1.2.0, <v2.0.0", Expires an
+// optional date (YYYY-MM-DD) after which the exception has to be renewed.
+type Exception struct {
+	Module        string `json:"module"`
+	Versions      string `json:"versions,omitempty"`
+	Justification string `json:"justification"`
+	Expires       string `json:"expires,omitempty"`
+}
+
+// DefaultPolicy returns the policy used without policy file: copyleft and
+// missing licenses are denied, everything else is allowed.
+func DefaultPolicy() *Policy {
+	return &Policy{
+		Default: VerdictAllow,
+		Deny:    criticalLicenseNicknames,
+	}
+}
+
+// LoadPolicy reads a policy from a JSON file. Licenses not listed in the
+// file need review, unless the file states another default.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := Policy{Default: VerdictReview}
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("Invalid policy %s: %s", path, err.Error())
+	}
+	if err := policy.validate(); err != nil {
+		return nil, fmt.Errorf("Invalid policy %s: %s", path, err.Error())
+	}
+	return &policy, nil
+}
+
+func (policy *Policy) validate() error {
+	switch policy.Default {
+	case VerdictAllow, VerdictReview, VerdictDeny:
+	default:
+		return fmt.Errorf("unknown default verdict %q", policy.Default)
+	}
+
+	seen := map[string]Verdict{}
+	lists := []struct {
+		verdict Verdict
+		names   []string
+	}{
+		{VerdictAllow, policy.Allow},
+		{VerdictReview, policy.Review},
+		{VerdictDeny, policy.Deny},
+	}
+	for _, list := range lists {
+		verdict := list.verdict
+		for _, name := range list.names {
+			if other, ok := seen[name]; ok && other != verdict {
+				return fmt.Errorf("%s is listed as %s and %s", name, other, verdict)
+			}
+			seen[name] = verdict
+		}
+	}
+
+	for _, exception := range policy.Exceptions {
+		if exception.Module == "" {
+			return fmt.Errorf("exception without module")
+		}
+		if strings.TrimSpace(exception.Justification) == "" {
+			return fmt.Errorf("exception for %s without justification", exception.Module)
+		}
+		if _, err := parseConstraints(exception.Versions); err != nil {
+			return fmt.Errorf("exception for %s: %s", exception.Module, err.Error())
+		}
+		if exception.Expires != "" {
+			if _, err := time.Parse(exceptionDateLayout, exception.Expires); err != nil {
+				return fmt.Errorf("exception for %s: invalid expiry date %q",
+					exception.Module, exception.Expires)
+			}
+		}
+	}
+	return nil
+}
+
+// IsExpired reports whether the exception is not valid anymore at the given
+// time. Exceptions are valid until the end of the day they expire.
+func (exception *Exception) IsExpired(now time.Time) bool {
+	if exception.Expires == "" {
+		return false
+	}
+	expires, err := time.Parse(exceptionDateLayout, exception.Expires)
+	if err != nil {
+		return true
+	}
+	return !now.Before(expires.AddDate(0, 0, 1))
+}
+
+func (exception *Exception) matches(module string, version string) bool {
+	if exception.Module != module {
+		return false
+	}
+	constraints, err := parseConstraints(exception.Versions)
+	if err != nil {
+		return false
+	}
+	for _, c := range constraints {
+		if !c.matches(version) {
+			return false
+		}
+	}
+	return true
+}
+
+// ExpiredExceptions returns all exceptions of the policy which are expired at
+// the given time.
+func (policy *Policy) ExpiredExceptions(now time.Time) []Exception {
+	expired := []Exception{}
+	for _, exception := range policy.Exceptions {
+		if exception.IsExpired(now) {
+			expired = append(expired, exception)
+		}
+	}
+	return expired
+}
+
+func containsLicense(names []string, template *Template) bool {
+	for _, name := range names {
+		if name == template.Nickname || name == template.SPDXID {
+			return true
+		}
+	}
+	return false
+}
+
+// Verdict returns the assessment of the license. Licenses which could not be
+// identified always need review. Licenses not identified without doubt are
+// never allowed, but get the default verdict for unknown licenses if that is
+// stricter.
+func (policy *Policy) Verdict(license *License) Verdict {
+	if license == nil || license.Template == nil {
+		return VerdictReview
+	}
+
+	verdict := policy.Default
+	switch {
+	case containsLicense(policy.Deny, license.Template):
+		verdict = VerdictDeny
+	case containsLicense(policy.Review, license.Template):
+		verdict = VerdictReview
+	case containsLicense(policy.Allow, license.Template):
+		verdict = VerdictAllow
+	}
+	if !license.IsConfident() && verdict == VerdictAllow {
+		if policy.Default == VerdictDeny {
+			return VerdictDeny
+		}
+		return VerdictReview
+	}
+	return verdict
+}
+
+var verdictRanks = map[Verdict]int{
+	VerdictAllow:  0,
+	VerdictReview: 1,
+	VerdictDeny:   2,
+}
+
+// ResultVerdict returns the assessment of all licenses of a package. If the
+// package offers a choice, the most favourable license counts, otherwise the
+// least favourable one.
+func (policy *Policy) ResultVerdict(result *Result) Verdict {
+	if result == nil || len(result.Licenses) == 0 {
+		return VerdictReview
+	}
+
+	verdict := policy.Verdict(result.Licenses[0])
+	for _, license := range result.Licenses[1:] {
+		other := policy.Verdict(license)
+		better := verdictRanks[other] < verdictRanks[verdict]
+		worse := verdictRanks[other] > verdictRanks[verdict]
+		if (result.Choice && better) || (!result.Choice && worse) {
+			verdict = other
+		}
+	}
+	return verdict
+}
+
+// Assess returns the verdict for a module with the given licenses. Modules
+// whose licenses are not allowed may be approved by an exception, which is
+// returned alongside. Expired exceptions do not approve anything.
+func (policy *Policy) Assess(module string, version string, result *Result) (Verdict, *Exception) {
+	verdict := policy.ResultVerdict(result)
+	if verdict == VerdictAllow {
+		return verdict, nil
+	}
+	now := time.Now()
+	for k := range policy.Exceptions {
+		exception := &policy.Exceptions[k]
+		if !exception.IsExpired(now) && exception.matches(module, version) {
+			return VerdictException, exception
+		}
+	}
+	return verdict, nil
+}
+
+type constraint struct {
+	op      string
+	version string
+}
+
+// parseConstraints parses a list of version constraints separated by commas
+// or spaces. A version without operator must match exactly.
+func parseConstraints(s string) ([]constraint, error) {
+	constraints := []constraint{}
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+	for k := 0; k < len(fields); k++ {
+		c := constraint{op: "="}
+		field := fields[k]
+		for _, op := range []string{">=", "<=", "!=", ">", "<", "="} {
+			if strings.HasPrefix(field, op) {
+				c.op = op
+				field = field[len(op):]
+				break
+			}
+		}
+		if field == "" && k+1 < len(fields) {
+			// operator and version separated by space
+			k++
+			field = fields[k]
+		}
+		if !isSemver(field) {
+			return nil, fmt.Errorf("invalid version %q", field)
+		}
+		c.version = field
+		constraints = append(constraints, c)
+	}
+	return constraints, nil
+}
+
+func (c constraint) matches(version string) bool {
+	if !isSemver(version) {
+		return false
+	}
+	cmp := compareSemver(version, c.version)
+	switch c.op {
+	case ">=":
+		return cmp >= 0
+	case "<=":
+		return cmp <= 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case "<":
+		return cmp < 0
+	}
+	return cmp == 0
+}
@@ -0,0 +1,101 @@
+/*
+ * go-vendor-licenses - policy_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import "testing"
+
+func TestPolicyVerdict(t *testing.T) {
+	zlib := &Template{Title: "zlib License", Nickname: "Zlib", SPDXID: "Zlib"}
+	gpl := &Template{Title: "GNU General Public License v3.0", Nickname: "GPL-3.0", SPDXID: "GPL-3.0-only"}
+	mit := &Template{Title: "MIT License", Nickname: "MIT", SPDXID: "MIT"}
+	policy := Policy{
+		Default: VerdictReview,
+		Allow:   []string{"Zlib"},
+		Review:  []string{"MIT"},
+		Deny:    []string{"GPL-3.0-only"},
+	}
+	denyUnknown := policy
+	denyUnknown.Default = VerdictDeny
+	allowUnknown := policy
+	allowUnknown.Default = VerdictAllow
+
+	tests := []struct {
+		name    string
+		policy  Policy
+		license *License
+		want    Verdict
+	}{
+		{"unidentified", policy, &License{}, VerdictReview},
+		{"allowed", policy, &License{Template: zlib, Score: 1}, VerdictAllow},
+		{"allowed low confidence", policy, &License{Template: zlib, Score: 0.33}, VerdictReview},
+		{"allowed low confidence, unknown denied", denyUnknown, &License{Template: zlib, Score: 0.33}, VerdictDeny},
+		{"review low confidence", policy, &License{Template: mit, Score: 0.33}, VerdictReview},
+		{"denied low confidence", policy, &License{Template: gpl, Score: 0.33}, VerdictDeny},
+		{"unlisted", allowUnknown, &License{Template: &Template{Nickname: "X"}, Score: 1}, VerdictAllow},
+		{"unlisted low confidence", allowUnknown, &License{Template: &Template{Nickname: "X"}, Score: 0.5}, VerdictReview},
+	}
+	for _, test := range tests {
+		if verdict := test.policy.Verdict(test.license); verdict != test.want {
+			t.Errorf("%s: verdict %s, want %s", test.name, verdict, test.want)
+		}
+	}
+}
+
+func TestDefaultPolicyDoesNotAllowLowConfidence(t *testing.T) {
+	zlib := &Template{Title: "zlib License", Nickname: "Zlib", SPDXID: "Zlib"}
+	result := &Result{Licenses: []*License{{Template: zlib, Score: 0.33}}}
+	if verdict, _ := DefaultPolicy().Assess("example.com/acme", "v1.0.0", result); verdict != VerdictReview {
+		t.Errorf("verdict %s, want %s", verdict, VerdictReview)
+	}
+}
+
+func TestDefaultPolicyDeniesCopyleft(t *testing.T) {
+	detector, err := defaultDetector()
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := DefaultPolicy()
+	for _, id := range []string{"EUPL-1.2", "LGPL-3.0-only", "LGPL-3.0-or-later", "GPL-1.0-or-later",
+		"OSL-2.1", "CPL-1.0", "CECILL-2.1", "Sleepycat", "CC-BY-SA-3.0", "AGPL-1.0-only"} {
+		license := &License{Template: detector.spdxTemplate(id), Score: 1}
+		if verdict := policy.Verdict(license); verdict == VerdictAllow {
+			t.Errorf("%s allowed by default", id)
+		}
+	}
+	for _, id := range []string{"MIT", "BSD-3-Clause", "Apache-2.0", "CECILL-B"} {
+		license := &License{Template: detector.spdxTemplate(id), Score: 1}
+		if verdict := policy.Verdict(license); verdict != VerdictAllow {
+			t.Errorf("%s not allowed by default: %s", id, verdict)
+		}
+	}
+}
+
+func TestAssessSkipsExpiredExceptions(t *testing.T) {
+	mpl := &Template{Title: "Mozilla Public License 2.0", Nickname: "MPL-2.0", SPDXID: "MPL-2.0"}
+	result := &Result{Licenses: []*License{{Template: mpl, Score: 1}}}
+	tests := []struct {
+		expires string
+		want    Verdict
+	}{
+		{"", VerdictException},
+		{"2999-12-31", VerdictException},
+		{"2000-01-01", VerdictDeny},
+	}
+	for _, test := range tests {
+		policy := DefaultPolicy()
+		policy.Exceptions = []Exception{{
+			Module:        "example.com/acme",
+			Justification: "approved by legal",
+			Expires:       test.expires,
+		}}
+		verdict, exception := policy.Assess("example.com/acme", "v1.0.0", result)
+		if verdict != test.want || (exception != nil) != (test.want == VerdictException) {
+			t.Errorf("expires %q: verdict %s, %v, want %s", test.expires, verdict, exception, test.want)
+		}
+	}
+}
@@ -0,0 +1,314 @@
+/*
+ * go-vendor-licenses - report.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"text/tabwriter"
+)
+
+// Module is a module or package whose licenses are reported.
+type Module struct {
+	Name     string
+	Version  string
+	Revision string
+	Branch   string
+	// Path is the directory with the sources of the module
+	Path string
+	// Main is set for the modules being built, as opposed to dependencies
+	Main bool
+	// ResolveErr is set if the module could not be resolved completely, like
+	// if its requirements are unknown. It is reported as a problem.
+	ResolveErr error
+}
+
+// ModuleReport holds the licenses of a module and their assessment.
+type ModuleReport struct {
+	Module
+	// Result is nil if the licenses could not be identified, see Err
+	Result    *Result
+	Err       error
+	Verdict   Verdict
+	Exception *Exception
+	// Disclaimer holds the license, copyright and similar files of the
+	// module after Report.ReadDisclaimers. DisclaimerErr is set if any of
+	// them cannot be read.
+	Disclaimer    []DisclaimerFile
+	DisclaimerErr error
+	// Notices holds the NOTICE files of the module, which have to be shipped
+	// with Apache licensed modules. NoticeErr is set if any of them cannot
+	// be read.
+	Notices   []DisclaimerFile
+	NoticeErr error
+}
+
+// DisclaimerFile is a file of a module which has to be shipped with it.
+type DisclaimerFile struct {
+	Name    string
+	Content []byte
+}
+
+// Report holds the licenses of modules, in the order they were given. It
+// does not write anything by itself, use the Write methods to render it.
+type Report struct {
+	Modules []*ModuleReport
+}
+
+// NewReport creates a report of the modules. Call Identify or
+// ReadDisclaimers to fill it.
+func NewReport(modules []Module) *Report {
+	report := Report{}
+	for _, module := range modules {
+		report.Modules = append(report.Modules, &ModuleReport{Module: module})
+	}
+	return &report
+}
+
+// Critical reports whether the module has no identified license or a
+// denied one.
+func (module *ModuleReport) Critical() bool {
+	return module.Result == nil || module.Verdict == VerdictDeny
+}
+
+// Identify identifies the licenses of all modules with jobs workers and
+// assesses them by the policy. A nil detector uses the embedded templates,
+// a nil policy the DefaultPolicy. Errors of single modules are kept in the
+// module reports, only a failing detector is returned.
+func (report *Report) Identify(detector *Detector, policy *Policy, jobs int) error {
+	if detector == nil {
+		var err error
+		detector, err = defaultDetector()
+		if err != nil {
+			return err
+		}
+	}
+	if policy == nil {
+		policy = DefaultPolicy()
+	}
+	if jobs < 1 {
+		jobs = 1
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for n := 0; n < jobs; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := range indexes {
+				module := report.Modules[k]
+				version := module.Version
+				if version == "" {
+					version = module.Revision
+				}
+				module.Result, module.Err = detector.IdentifyModule(module.Name, version, module.Path)
+				module.Notices, module.NoticeErr = readNotices(module.Path)
+			}
+		}()
+	}
+
+	for k, module := range report.Modules {
+		if module.Path == "" {
+			module.Err = &ReadError{Path: module.Name, Err: ErrNoSource}
+			continue
+		}
+		indexes <- k
+	}
+	close(indexes)
+	wg.Wait()
+
+	for _, module := range report.Modules {
+		module.Verdict, module.Exception = policy.Assess(module.Name, module.Version, module.Result)
+	}
+	return nil
+}
+
+// Problems returns the problems found with the licenses of the module: the
+// error resolving the module, the identification error, a LowConfidenceError for every license if none of
+// them is identified without doubt and a DeniedError if the policy denies
+// the licenses. Missing and doubtful licenses of modules approved by an
+// exception are no problem. Use errors.As to tell them apart.
+func (module *ModuleReport) Problems() []error {
+	problems := []error{}
+	approved := module.Verdict == VerdictException
+	var noLicense *NoLicenseError
+	if module.ResolveErr != nil {
+		problems = append(problems, module.ResolveErr)
+	}
+	if module.Err != nil && !(approved && errors.As(module.Err, &noLicense)) {
+		problems = append(problems, module.Err)
+	}
+	nicknames := []string{"?"}
+	if module.Result != nil {
+		doubtful := !approved && !module.Result.hasConfident()
+		for _, license := range module.Result.Licenses {
+			if doubtful {
+				problems = append(problems, &LowConfidenceError{Module: module.Name, License: license})
+			}
+		}
+		nicknames = module.Result.Nicknames()
+	}
+	if module.NoticeErr != nil && module.requiresNotice() {
+		problems = append(problems, &NoticeError{Module: module.Name, Err: module.NoticeErr})
+	}
+	if module.DisclaimerErr != nil {
+		problems = append(problems, &DisclaimerError{Module: module.Name, Err: module.DisclaimerErr})
+	}
+	if module.Verdict == VerdictDeny {
+		problems = append(problems, &DeniedError{Module: module.Name, Nicknames: nicknames})
+	}
+	return problems
+}
+
+// requiresNotice reports whether the NOTICE files of the module have to be
+// redistributed, as the Apache License requires.
+func (module *ModuleReport) requiresNotice() bool {
+	if module.Result == nil {
+		return false
+	}
+	for _, license := range module.Result.Licenses {
+		if license.Template != nil && license.Template.SPDXID == "Apache-2.0" {
+			return true
+		}
+	}
+	return false
+}
+
+// Problems returns the problems of all modules.
+func (report *Report) Problems() []error {
+	problems := []error{}
+	for _, module := range report.Modules {
+		problems = append(problems, module.Problems()...)
+	}
+	return problems
+}
+
+// Denied reports whether the license of any module is denied.
+func (report *Report) Denied() bool {
+	for _, module := range report.Modules {
+		if module.Verdict == VerdictDeny {
+			return true
+		}
+	}
+	return false
+}
+
+// Obligations aggregates the obligations of the licenses of all
+// dependencies. The main modules do not oblige to anything.
+func (report *Report) Obligations() []Obligation {
+	modules := []string{}
+	results := []*Result{}
+	for _, module := range report.Modules {
+		if module.Main {
+			continue
+		}
+		modules = append(modules, module.Name)
+		results = append(results, module.Result)
+	}
+	return AggregateObligations(modules, results)
+}
+
+// ReadDisclaimers reads the disclaimer files of all modules. Files which
+// cannot be read are reported as problems of their modules.
+func (report *Report) ReadDisclaimers() {
+	for _, module := range report.Modules {
+		if module.Path == "" {
+			module.DisclaimerErr = ErrNoSource
+			continue
+		}
+		module.Disclaimer, module.DisclaimerErr = ReadDisclaimer(module.Path)
+	}
+}
+
+// WriteDisclaimers writes the disclaimer files of all modules read by
+// ReadDisclaimers.
+func (report *Report) WriteDisclaimers(w io.Writer) error {
+	for _, module := range report.Modules {
+		if err := WriteDisclaimer(w, module.Name, module.Disclaimer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadDisclaimer reads the license, copyright, author, contributor, patent
+// and NOTICE files of the package in path. The readable files are returned
+// together with the error of the others.
+func ReadDisclaimer(path string) ([]DisclaimerFile, error) {
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var readErr error
+	disclaimer := []DisclaimerFile{}
+	for _, file := range files {
+		if !file.Mode().IsRegular() || !matchDisclaimName(file.Name()) {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(path, file.Name()))
+		if err != nil {
+			readErr = err
+			continue
+		}
+		disclaimer = append(disclaimer, DisclaimerFile{
+			Name:    file.Name(),
+			Content: content,
+		})
+	}
+	return disclaimer, readErr
+}
+
+// readNotices reads the NOTICE files of the package in path. The readable
+// files are returned together with the error of the others.
+func readNotices(path string) ([]DisclaimerFile, error) {
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var readErr error
+	notices := []DisclaimerFile{}
+	for _, file := range files {
+		if file.IsDir() || !regexNotice.MatchString(file.Name()) {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(path, file.Name()))
+		if err != nil {
+			readErr = err
+			continue
+		}
+		notices = append(notices, DisclaimerFile{
+			Name:    file.Name(),
+			Content: content,
+		})
+	}
+	return notices, readErr
+}
+
+// WriteDisclaimer writes the disclaimer files of the package pkg.
+func WriteDisclaimer(w io.Writer, pkg string, files []DisclaimerFile) error {
+	writer := tabwriter.NewWriter(w, 1, 4, 2, ' ', 0)
+
+	disclaimer := fmt.Sprintf("\nDISCLAIMER of %s:\n", pkg)
+	for _, file := range files {
+		disclaimer += fmt.Sprintf("\nFilename: %s\n", file.Name)
+		disclaimer += string(file.Content)
+	}
+
+	_, err := writer.Write([]byte(disclaimer + "\n"))
+	if err != nil {
+		return err
+	}
+	return writer.Flush()
+}
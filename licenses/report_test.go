@@ -0,0 +1,162 @@
+/*
+ * go-vendor-licenses - report_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadDisclaimersContinues(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"LICENSE": "license text\n",
+		"main.go": "package main\n",
+	})
+	report := NewReport([]Module{
+		{Name: "example.com/missing", Path: filepath.Join(dir, "missing")},
+		{Name: "example.com/m", Path: dir},
+	})
+	report.ReadDisclaimers()
+
+	missing, m := report.Modules[0], report.Modules[1]
+	if missing.DisclaimerErr == nil {
+		t.Errorf("no error for missing module")
+	}
+	if m.DisclaimerErr != nil || len(m.Disclaimer) != 1 || m.Disclaimer[0].Name != "LICENSE" {
+		t.Errorf("disclaimer %v, %v, want LICENSE", m.Disclaimer, m.DisclaimerErr)
+	}
+
+	var disclaimerErr *DisclaimerError
+	if problems := missing.Problems(); len(problems) != 1 || !errors.As(problems[0], &disclaimerErr) {
+		t.Errorf("problems %v, want a DisclaimerError", problems)
+	}
+	if problems := m.Problems(); len(problems) != 0 {
+		t.Errorf("problems %v, want none", problems)
+	}
+}
+
+func TestReadDisclaimerSkipsUnreadable(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("files are always readable by root")
+	}
+	dir := writeModule(t, map[string]string{
+		"LICENSE": "license text\n",
+		"NOTICE":  "notice\n",
+	})
+	if err := os.Chmod(filepath.Join(dir, "NOTICE"), 0); err != nil {
+		t.Fatal(err)
+	}
+	files, err := ReadDisclaimer(dir)
+	if err == nil {
+		t.Errorf("no error for unreadable NOTICE")
+	}
+	if len(files) != 1 || files[0].Name != "LICENSE" {
+		t.Errorf("files %v, want LICENSE", files)
+	}
+}
+
+func TestProblemsOfApprovedModules(t *testing.T) {
+	mit := &Template{Title: "MIT License", Nickname: "MIT", SPDXID: "MIT"}
+	exception := &Exception{Module: "example.com/m", Justification: "approved by legal"}
+	tests := []struct {
+		name   string
+		module ModuleReport
+		want   int
+	}{
+		{"no license", ModuleReport{
+			Err:     &NoLicenseError{Path: "/m"},
+			Verdict: VerdictReview,
+		}, 1},
+		{"no license approved", ModuleReport{
+			Err:       &NoLicenseError{Path: "/m"},
+			Verdict:   VerdictException,
+			Exception: exception,
+		}, 0},
+		{"low confidence", ModuleReport{
+			Result:  &Result{Licenses: []*License{{Template: mit, Score: 0.5}}},
+			Verdict: VerdictReview,
+		}, 1},
+		{"low confidence approved", ModuleReport{
+			Result:    &Result{Licenses: []*License{{Template: mit, Score: 0.5}}},
+			Verdict:   VerdictException,
+			Exception: exception,
+		}, 0},
+		{"unreadable approved", ModuleReport{
+			Err:       &ReadError{Path: "/m", Err: os.ErrPermission},
+			Verdict:   VerdictException,
+			Exception: exception,
+		}, 1},
+	}
+	for _, test := range tests {
+		test.module.Name = "example.com/m"
+		if problems := test.module.Problems(); len(problems) != test.want {
+			t.Errorf("%s: problems %v, want %d", test.name, problems, test.want)
+		}
+	}
+}
+
+func TestProblemsIgnoreDoubtfulSecondaryFiles(t *testing.T) {
+	mit, err := ioutil.ReadFile(filepath.Join("assets", "spdx", "text", "MIT.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := writeModule(t, map[string]string{
+		"LICENSE": string(mit),
+		"LICENSE.docs": "The documentation in docs/ may be copied freely,\n" +
+			"see the website for details.\n",
+		"main.go": "package main\n",
+	})
+	report := NewReport([]Module{{Name: "example.com/m", Version: "v1.0.0", Path: dir}})
+	detector, err := NewDetector()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := report.Identify(detector, DefaultPolicy(), 1); err != nil {
+		t.Fatal(err)
+	}
+	module := report.Modules[0]
+	if module.Result == nil || len(module.Result.Licenses) != 2 {
+		t.Fatalf("result %v, %v, want two license files", module.Result, module.Err)
+	}
+	if problems := module.Problems(); len(problems) != 0 {
+		t.Errorf("problems %v, want none", problems)
+	}
+
+	// Without a confident license the doubtful files are reported
+	module.Result.Licenses = module.Result.Licenses[1:]
+	var lowConfidence *LowConfidenceError
+	if problems := module.Problems(); len(problems) != 1 || !errors.As(problems[0], &lowConfidence) {
+		t.Errorf("problems %v, want a LowConfidenceError", problems)
+	}
+}
+
+func TestIdentifyModulesWithoutSources(t *testing.T) {
+	report := NewReport([]Module{{Name: "example.com/m", Version: "v1.0.0"}})
+	detector, err := NewDetector()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := report.Identify(detector, DefaultPolicy(), 1); err != nil {
+		t.Fatal(err)
+	}
+	report.ReadDisclaimers()
+	problems := report.Problems()
+	if len(problems) != 2 {
+		t.Fatalf("problems %v, want two", problems)
+	}
+	var readErr *ReadError
+	if !errors.As(problems[0], &readErr) || !errors.Is(problems[0], ErrNoSource) {
+		t.Errorf("problem %v, want a ReadError without sources", problems[0])
+	}
+	if !errors.Is(problems[1], ErrNoSource) {
+		t.Errorf("problem %v, want a disclaimer without sources", problems[1])
+	}
+}
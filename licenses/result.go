@@ -0,0 +1,164 @@
+/*
+ * go-vendor-licenses - result.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	regexReadme = regexp.MustCompile(`(?i)^readme(?:\.[^.]+)?$`)
+	regexChoice = regexp.MustCompile(`(?i)(dual[- ]licen[sc]ed|` +
+		`at your (?:option|choice)|` +
+		`licen[sc]ed under either)`)
+)
+
+// Result holds all licenses found for a package. Expression combines the
+// SPDX identifiers of the confidently identified licenses.
+type Result struct {
+	Licenses   []*License
+	Expression string
+	// Choice is set if the package may be used under any of the licenses
+	Choice bool
+	// Tags are the license expressions of the SPDX-License-Identifier tags
+	// in the Go files. They are only scanned without license files or on
+	// request.
+	Tags []string
+	// Copyrights are the copyrights stated in the license files and, on
+	// request, in the headers of the Go files
+	Copyrights []Copyright
+}
+
+// Best returns the license of the most likely license file.
+func (result *Result) Best() *License {
+	if len(result.Licenses) == 0 {
+		return nil
+	}
+	return result.Licenses[0]
+}
+
+// hasConfident reports whether any license is identified without doubt.
+// Further license files, like notes on the license, may not match any
+// template.
+func (result *Result) hasConfident() bool {
+	for _, license := range result.Licenses {
+		if license.IsConfident() {
+			return true
+		}
+	}
+	return false
+}
+
+// Nicknames returns the distinct template nicknames of all licenses.
+func (result *Result) Nicknames() []string {
+	nicknames := []string{}
+	seen := map[string]bool{}
+	for _, license := range result.Licenses {
+		if license.Template == nil || seen[license.Template.Nickname] {
+			continue
+		}
+		seen[license.Template.Nickname] = true
+		nicknames = append(nicknames, license.Template.Nickname)
+	}
+	return nicknames
+}
+
+// String returns the human readable description of all licenses as used in
+// the manifest.
+func (result *Result) String() string {
+	if len(result.Licenses) > 0 && result.Licenses[0].Tag {
+		return "SPDX-License-Identifier: " + strings.Join(result.Tags, ", ")
+	}
+	if len(result.Licenses) == 1 {
+		return result.Licenses[0].String()
+	}
+	descriptions := []string{}
+	for _, license := range result.Licenses {
+		descriptions = append(descriptions,
+			license.summary()+" ["+filepath.Base(license.Path)+"]"+license.wordLines())
+	}
+	return strings.Join(descriptions, "\n\t")
+}
+
+// isChoice reports whether the package in path offers a choice between its
+// licenses. That is assumed if the license files are named after the
+// licenses (LICENSE-APACHE, LICENSE-MIT) or the README says so.
+func isChoice(path string, licenseFiles []string) bool {
+	suffixed := 0
+	for _, file := range licenseFiles {
+		if isSuffixedLicenseName(filepath.Base(file)) {
+			suffixed++
+		}
+	}
+	if suffixed > 1 {
+		return true
+	}
+
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return false
+	}
+	for _, file := range files {
+		if !file.Mode().IsRegular() || !regexReadme.MatchString(file.Name()) {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(path, file.Name()))
+		if err == nil && regexChoice.Match(content) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildExpression combines the SPDX identifiers of all confidently
+// identified licenses.
+func buildExpression(licenses []*License, choice bool) string {
+	ids := []string{}
+	seen := map[string]bool{}
+	for _, license := range licenses {
+		if !license.IsConfident() || seen[license.Template.SPDXID] {
+			continue
+		}
+		seen[license.Template.SPDXID] = true
+		ids = append(ids, license.Template.SPDXID)
+	}
+
+	operator := " AND "
+	if choice {
+		operator = " OR "
+	}
+	return strings.Join(ids, operator)
+}
+
+// TagMismatches returns the expressions of the SPDX-License-Identifier tags
+// which name licenses not found in the license files.
+func (result *Result) TagMismatches() []string {
+	ids := map[string]bool{}
+	for _, license := range result.Licenses {
+		if license.Tag {
+			return nil
+		}
+		ids[license.Template.SPDXID] = true
+		ids[license.Template.Nickname] = true
+	}
+
+	mismatches := []string{}
+	for _, tag := range result.Tags {
+		for _, id := range expressionIDs(tag) {
+			base := strings.TrimSuffix(strings.TrimSuffix(id, "-or-later"), "+")
+			if !ids[id] && !ids[base] && !ids[base+"-only"] {
+				mismatches = append(mismatches, tag)
+				break
+			}
+		}
+	}
+	return mismatches
+}
@@ -0,0 +1,114 @@
+/*
+ * go-vendor-licenses - semver.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"strconv"
+	"strings"
+)
+
+type semver struct {
+	numbers    [3]int
+	prerelease []string
+}
+
+func parseSemver(v string) (semver, bool) {
+	sv := semver{}
+	if !strings.HasPrefix(v, "v") {
+		return sv, false
+	}
+	v = v[1:]
+	if i := strings.Index(v, "+"); i >= 0 {
+		v = v[:i]
+	}
+	if i := strings.Index(v, "-"); i >= 0 {
+		sv.prerelease = strings.Split(v[i+1:], ".")
+		v = v[:i]
+	}
+	parts := strings.Split(v, ".")
+	if len(parts) > 3 {
+		return sv, false
+	}
+	for k, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return sv, false
+		}
+		sv.numbers[k] = n
+	}
+	return sv, true
+}
+
+func isSemver(v string) bool {
+	_, ok := parseSemver(v)
+	return ok
+}
+
+// CompareVersions compares two module versions, which are semantic versions
+// with leading "v", and returns -1, 0 or +1.
+func CompareVersions(a string, b string) int {
+	return compareSemver(a, b)
+}
+
+// compareSemver compares two semantic versions with leading "v" and returns
+// -1, 0 or +1. Build metadata is ignored.
+func compareSemver(a string, b string) int {
+	va, _ := parseSemver(a)
+	vb, _ := parseSemver(b)
+	for k := 0; k < 3; k++ {
+		if va.numbers[k] != vb.numbers[k] {
+			if va.numbers[k] < vb.numbers[k] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	// A version without prerelease has higher precedence
+	switch {
+	case len(va.prerelease) == 0 && len(vb.prerelease) == 0:
+		return 0
+	case len(va.prerelease) == 0:
+		return 1
+	case len(vb.prerelease) == 0:
+		return -1
+	}
+	for k := 0; k < len(va.prerelease) && k < len(vb.prerelease); k++ {
+		if cmp := comparePrerelease(va.prerelease[k], vb.prerelease[k]); cmp != 0 {
+			return cmp
+		}
+	}
+	switch {
+	case len(va.prerelease) < len(vb.prerelease):
+		return -1
+	case len(va.prerelease) > len(vb.prerelease):
+		return 1
+	}
+	return 0
+}
+
+// comparePrerelease compares prerelease identifiers: numeric ones are
+// compared as numbers and sort before alphanumeric ones.
+func comparePrerelease(a string, b string) int {
+	na, errA := strconv.Atoi(a)
+	nb, errB := strconv.Atoi(b)
+	switch {
+	case errA == nil && errB == nil:
+		if na < nb {
+			return -1
+		} else if na > nb {
+			return 1
+		}
+		return 0
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,108 @@
+/*
+ * go-vendor-licenses - semver_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompareSemver(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"v1.0.0", "v1.0.0", 0},
+		{"v1.0.0", "v1.0.1", -1},
+		{"v1.10.0", "v1.9.0", 1},
+		{"v2.0.0+incompatible", "v2.0.0", 0},
+		{"v1.0.0-rc.1", "v1.0.0", -1},
+		{"v1.0.0-alpha", "v1.0.0-alpha.1", -1},
+		{"v1.0.0-alpha.1", "v1.0.0-alpha.beta", -1},
+		{"v1.0.0-beta.11", "v1.0.0-beta.2", 1},
+		{"v0.0.0-20200101000000-abcdef123456", "v0.0.0-20210101000000-abcdef123456", -1},
+		{"v1.2", "v1.2.0", 0},
+	}
+	for _, test := range tests {
+		if cmp := CompareVersions(test.a, test.b); cmp != test.want {
+			t.Errorf("CompareVersions(%s, %s) = %d, want %d", test.a, test.b, cmp, test.want)
+		}
+		if cmp := CompareVersions(test.b, test.a); cmp != -test.want {
+			t.Errorf("CompareVersions(%s, %s) = %d, want %d", test.b, test.a, cmp, -test.want)
+		}
+	}
+}
+
+func TestIsSemver(t *testing.T) {
+	for v, want := range map[string]bool{
+		"v1.2.3":              true,
+		"v1.2.3-pre+build":    true,
+		"v1":                  true,
+		"1.2.3":               false,
+		"v1.2.3.4":            false,
+		"v1.x":                false,
+		"v-1.0.0":             false,
+		"":                    false,
+		"v2.0.0+incompatible": true,
+	} {
+		if isSemver(v) != want {
+			t.Errorf("isSemver(%q) = %v, want %v", v, !want, want)
+		}
+	}
+}
+
+func TestParseConstraints(t *testing.T) {
+	tests := []struct {
+		text string
+		want []constraint
+	}{
+		{"", []constraint{}},
+		{"v1.2.3", []constraint{{"=", "v1.2.3"}}},
+		{">=v1.0.0, <v2.0.0", []constraint{{">=", "v1.0.0"}, {"<", "v2.0.0"}}},
+		{">= v1.0.0 != v1.1.0", []constraint{{">=", "v1.0.0"}, {"!=", "v1.1.0"}}},
+		{"<=v1.4.0\t>v1.0.0", []constraint{{"<=", "v1.4.0"}, {">", "v1.0.0"}}},
+	}
+	for _, test := range tests {
+		constraints, err := parseConstraints(test.text)
+		if err != nil || !reflect.DeepEqual(constraints, test.want) {
+			t.Errorf("parseConstraints(%q) = %v, %v, want %v", test.text, constraints, err, test.want)
+		}
+	}
+
+	for _, text := range []string{"1.0.0", ">=", ">=v1.0.0, <latest", "~v1.0.0"} {
+		if _, err := parseConstraints(text); err == nil {
+			t.Errorf("invalid constraints %q accepted", text)
+		}
+	}
+}
+
+func TestExceptionMatchesVersions(t *testing.T) {
+	exception := Exception{Module: "example.com/m", Versions: ">=v1.2.0, <v2.0.0, !=v1.3.0"}
+	tests := []struct {
+		module, version string
+		want            bool
+	}{
+		{"example.com/m", "v1.2.0", true},
+		{"example.com/m", "v1.9.9", true},
+		{"example.com/m", "v1.3.0", false},
+		{"example.com/m", "v1.2.0-rc.1", false},
+		{"example.com/m", "v2.0.0", false},
+		{"example.com/m", "(devel)", false},
+		{"example.com/other", "v1.2.0", false},
+	}
+	for _, test := range tests {
+		if matches := exception.matches(test.module, test.version); matches != test.want {
+			t.Errorf("exception matches %s@%s: %v, want %v", test.module, test.version, matches, test.want)
+		}
+	}
+
+	all := Exception{Module: "example.com/m"}
+	if !all.matches("example.com/m", "v0.0.0-20200101000000-abcdef123456") {
+		t.Errorf("exception without versions does not match all versions")
+	}
+}
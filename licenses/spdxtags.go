@@ -0,0 +1,173 @@
+/*
+ * go-vendor-licenses - spdxtags.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	regexSPDXTag   = regexp.MustCompile(`SPDX-License-Identifier:\s*(.*)`)
+	regexSPDXToken = regexp.MustCompile(`[()]|[^\s()]+`)
+)
+
+// spdxTag is a license expression found in SPDX-License-Identifier tags and
+// the first file it was found in.
+type spdxTag struct {
+	expression string
+	file       string
+}
+
+// scanSPDXTags returns the distinct license expressions of the
+// SPDX-License-Identifier tags in the Go files of the module in path. Only
+// the header of every file, up to the package clause, is scanned. Vendored
+// packages, test data and nested modules are skipped.
+func scanSPDXTags(path string) ([]spdxTag, error) {
+	files := map[string]string{}
+	err := walkGoFiles(path, func(file string) error {
+		header, err := readHeader(file)
+		if err != nil {
+			return err
+		}
+		for _, expression := range spdxTagExpressions(header) {
+			if _, ok := files[expression]; !ok {
+				files[expression] = file
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []spdxTag{}
+	for expression, file := range files {
+		tags = append(tags, spdxTag{expression: expression, file: file})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].expression < tags[j].expression
+	})
+	return tags, nil
+}
+
+// walkGoFiles calls fn for all Go files of the module in path, in lexical
+// order. Vendored packages, test data and nested modules are skipped.
+func walkGoFiles(path string, fn func(file string) error) error {
+	return filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			name := info.Name()
+			if file == path {
+				return nil
+			}
+			if name == "vendor" || name == "testdata" ||
+				strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(file, "go.mod")); err == nil {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() || !strings.HasSuffix(file, ".go") {
+			return nil
+		}
+		return fn(file)
+	})
+}
+
+// readHeader returns the lines of the Go file up to the package clause.
+func readHeader(file string) ([]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	lines := []string{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "package ") {
+			break
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+func spdxTagExpressions(header []string) []string {
+	expressions := []string{}
+	for _, line := range header {
+		m := regexSPDXTag.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		expression := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(m[1]), "*/"))
+		if expression != "" {
+			expressions = append(expressions, expression)
+		}
+	}
+	return expressions
+}
+
+// expressionIDs returns the license identifiers of an SPDX license
+// expression, without operators and exceptions.
+func expressionIDs(expression string) []string {
+	ids := []string{}
+	tokens := regexSPDXToken.FindAllString(expression, -1)
+	for k := 0; k < len(tokens); k++ {
+		switch strings.ToUpper(tokens[k]) {
+		case "(", ")", "AND", "OR":
+		case "WITH":
+			// skip the exception
+			k++
+		default:
+			ids = append(ids, tokens[k])
+		}
+	}
+	return ids
+}
+
+// isOrExpression reports whether the expression only offers a choice of
+// licenses.
+func isOrExpression(expression string) bool {
+	hasOr := false
+	for _, token := range regexSPDXToken.FindAllString(expression, -1) {
+		switch strings.ToUpper(token) {
+		case "OR":
+			hasOr = true
+		case "AND":
+			return false
+		}
+	}
+	return hasOr
+}
+
+// tagsExpression combines the expressions of all tags, which all apply.
+func tagsExpression(tags []string) string {
+	if len(tags) == 1 {
+		return tags[0]
+	}
+	parts := []string{}
+	for _, tag := range tags {
+		if len(regexSPDXToken.FindAllString(tag, -1)) > 1 {
+			tag = "(" + tag + ")"
+		}
+		parts = append(parts, tag)
+	}
+	return strings.Join(parts, " AND ")
+}
@@ -0,0 +1,119 @@
+/*
+ * go-vendor-licenses - spdxtags_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpressionIDs(t *testing.T) {
+	tests := []struct {
+		expression string
+		ids        []string
+		or         bool
+	}{
+		{"MIT", []string{"MIT"}, false},
+		{"MIT OR Apache-2.0", []string{"MIT", "Apache-2.0"}, true},
+		{"mit or Apache-2.0", []string{"mit", "Apache-2.0"}, true},
+		{"BSD-3-Clause AND MIT", []string{"BSD-3-Clause", "MIT"}, false},
+		{"(MIT OR Apache-2.0) AND BSD-2-Clause", []string{"MIT", "Apache-2.0", "BSD-2-Clause"}, false},
+		{"GPL-2.0-or-later WITH Classpath-exception-2.0", []string{"GPL-2.0-or-later"}, false},
+		{"(GPL-2.0-only WITH Linux-syscall-note OR MIT)", []string{"GPL-2.0-only", "MIT"}, true},
+		{"LicenseRef-Custom", []string{"LicenseRef-Custom"}, false},
+		{"", []string{}, false},
+	}
+	for _, test := range tests {
+		if ids := expressionIDs(test.expression); !reflect.DeepEqual(ids, test.ids) {
+			t.Errorf("expressionIDs(%q) = %q, want %q", test.expression, ids, test.ids)
+		}
+		if or := isOrExpression(test.expression); or != test.or {
+			t.Errorf("isOrExpression(%q) = %v, want %v", test.expression, or, test.or)
+		}
+	}
+}
+
+func TestTagsExpression(t *testing.T) {
+	tests := []struct {
+		tags       []string
+		expression string
+	}{
+		{[]string{"MIT"}, "MIT"},
+		{[]string{"MIT OR Apache-2.0"}, "MIT OR Apache-2.0"},
+		{[]string{"MIT", "BSD-3-Clause"}, "MIT AND BSD-3-Clause"},
+		{[]string{"MIT OR Apache-2.0", "BSD-3-Clause"}, "(MIT OR Apache-2.0) AND BSD-3-Clause"},
+	}
+	for _, test := range tests {
+		if expression := tagsExpression(test.tags); expression != test.expression {
+			t.Errorf("tagsExpression(%q) = %q, want %q", test.tags, expression, test.expression)
+		}
+	}
+}
+
+func TestSPDXTagExpressions(t *testing.T) {
+	header := []string{
+		"// Copyright 2020 Example Authors",
+		"// SPDX-License-Identifier: Apache-2.0",
+		"/* SPDX-License-Identifier: MIT OR Apache-2.0 */",
+		"# SPDX-License-Identifier:",
+		"//go:build linux",
+	}
+	want := []string{"Apache-2.0", "MIT OR Apache-2.0"}
+	if expressions := spdxTagExpressions(header); !reflect.DeepEqual(expressions, want) {
+		t.Errorf("expressions %q, want %q", expressions, want)
+	}
+}
+
+func TestTagMismatches(t *testing.T) {
+	mit := &Template{Nickname: "MIT", SPDXID: "MIT"}
+	gpl := &Template{Nickname: "GPL-2.0", SPDXID: "GPL-2.0-only"}
+	result := Result{
+		Licenses: []*License{{Template: mit, Score: 1}, {Template: gpl, Score: 1}},
+		Tags:     []string{"MIT", "GPL-2.0-or-later", "GPL-2.0+ OR MIT", "Apache-2.0 OR MIT"},
+	}
+	want := []string{"Apache-2.0 OR MIT"}
+	if mismatches := result.TagMismatches(); !reflect.DeepEqual(mismatches, want) {
+		t.Errorf("mismatches %q, want %q", mismatches, want)
+	}
+}
+
+func TestIdentifyDoesNotTrustUnknownTags(t *testing.T) {
+	detector, err := NewDetector()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		tag       string
+		confident bool
+	}{
+		{"MIT", true},
+		{"Apache-2.0", true},
+		{"GPL-1.0-or-later", true},
+		{"MTI", false},
+		{"LicenseRef-Custom", false},
+	}
+	for _, test := range tests {
+		dir := writeModule(t, map[string]string{
+			"a.go": "// SPDX-License-Identifier: " + test.tag + "\n\npackage a\n",
+		})
+		result, err := detector.Identify(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Licenses) != 1 || !result.Licenses[0].Tag {
+			t.Fatalf("%s: licenses %v, want the tag", test.tag, result.Licenses)
+		}
+		license := result.Licenses[0]
+		if license.IsConfident() != test.confident {
+			t.Errorf("%s: confident %v, want %v", test.tag, license.IsConfident(), test.confident)
+		}
+		if verdict := DefaultPolicy().ResultVerdict(result); !test.confident && verdict == VerdictAllow {
+			t.Errorf("%s: allowed by default", test.tag)
+		}
+	}
+}
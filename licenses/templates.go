@@ -0,0 +1,120 @@
+/*
+ * go-vendor-licenses - templates.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	regexSPDXID = regexp.MustCompile(`^[A-Za-z0-9.-]+$`)
+	// regexSPDXIDInvalid matches the characters not allowed in SPDX
+	// identifiers
+	regexSPDXIDInvalid = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+)
+
+// LoadTemplates adds the license templates in the .txt files of dir to the
+// detector. They use the front matter of the embedded templates, of which
+// title and nickname are required. A template replaces the embedded one
+// with the same nickname. Without spdx-id, "LicenseRef-" and the nickname is
+// used as SPDX identifier, as these are usually licenses not on the SPDX
+// license list. Characters not allowed in SPDX identifiers are replaced by
+// "-" then. All invalid files are reported in the error, no template is
+// added then. Templates have to be loaded before identifying licenses.
+func (detector *Detector) LoadTemplates(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	templates := []*Template{}
+	problems := []string{}
+	seen := map[string]string{}
+	hash := sha256.New()
+	hash.Write([]byte(detector.fingerprint))
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		t, err := parseTemplate(string(content))
+		if err == nil {
+			err = validateTemplate(t)
+		}
+		if err == nil {
+			if other, ok := seen[t.Nickname]; ok {
+				err = fmt.Errorf("nickname %s is already used by %s", t.Nickname, other)
+			}
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s", file, err.Error()))
+			continue
+		}
+		seen[t.Nickname] = filepath.Base(file)
+		templates = append(templates, t)
+		fmt.Fprintf(hash, "\x00%s\x00%x", filepath.Base(file), sha256.Sum256(content))
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("Invalid license templates in %s:\n\t%s", dir, strings.Join(problems, "\n\t"))
+	}
+
+	for _, t := range templates {
+		detector.addTemplate(t)
+	}
+	detector.fingerprint = hex.EncodeToString(hash.Sum(nil))
+	return nil
+}
+
+func validateTemplate(t *Template) error {
+	if t.Title == "" {
+		return fmt.Errorf("missing title")
+	}
+	if t.Nickname == "" {
+		return fmt.Errorf("missing nickname")
+	}
+	if strings.ContainsAny(t.Nickname, " \t") {
+		return fmt.Errorf("nickname %q contains whitespace", t.Nickname)
+	}
+	if t.SPDXID == "" {
+		t.SPDXID = "LicenseRef-" + regexSPDXIDInvalid.ReplaceAllString(t.Nickname, "-")
+	} else if !regexSPDXID.MatchString(strings.TrimPrefix(t.SPDXID, "LicenseRef-")) {
+		return fmt.Errorf("invalid spdx-id %q", t.SPDXID)
+	}
+	if len(t.Words) == 0 {
+		return fmt.Errorf("no license text")
+	}
+	return nil
+}
+
+// addTemplate adds a template to the detector, replacing a template with
+// the same nickname.
+func (detector *Detector) addTemplate(t *Template) {
+	if old, ok := detector.nicknames[t.Nickname]; ok {
+		for k := range detector.templates {
+			if detector.templates[k] == old {
+				detector.templates[k] = t
+			}
+		}
+		if detector.spdxIDs[old.SPDXID] == old {
+			delete(detector.spdxIDs, old.SPDXID)
+		}
+	} else {
+		detector.templates = append(detector.templates, t)
+	}
+	detector.nicknames[t.Nickname] = t
+	detector.spdxIDs[t.SPDXID] = t
+}
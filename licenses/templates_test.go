@@ -0,0 +1,61 @@
+/*
+ * go-vendor-licenses - templates_test.go
+ * Copyright (c) 2026, TQ-Systems GmbH. All rights reserved.
+ * Use of this source code is governed by a BSD-style license
+ * that can be found in the LICENSE file.
+ */
+
+package licenses
+
+import (
+	"testing"
+)
+
+// templateFile returns a template file with the front matter lines.
+func templateFile(frontMatter string) string {
+	return "---\n" + frontMatter + "---\n\nAcme may be used by anyone who says thank you.\n"
+}
+
+func TestLoadTemplatesSPDXID(t *testing.T) {
+	tests := []struct {
+		name        string
+		frontMatter string
+		spdxID      string
+	}{
+		{"derived", "title: Acme License\nnickname: Acme-1.0\n", "LicenseRef-Acme-1.0"},
+		{"derived from invalid characters", "title: Acme License\nnickname: Acme_License+\n", "LicenseRef-Acme-License-"},
+		{"configured", "title: Acme License\nnickname: Acme_License\nspdx-id: LicenseRef-Acme\n", "LicenseRef-Acme"},
+		{"configured invalid", "title: Acme License\nnickname: Acme\nspdx-id: LicenseRef-Acme_1\n", ""},
+		{"configured invalid without prefix", "title: Acme License\nnickname: Acme\nspdx-id: Acme+\n", ""},
+	}
+	for _, test := range tests {
+		detector, err := NewDetector()
+		if err != nil {
+			t.Fatal(err)
+		}
+		dir := writeModule(t, map[string]string{"acme.txt": templateFile(test.frontMatter)})
+		err = detector.LoadTemplates(dir)
+		if test.spdxID == "" {
+			if err == nil {
+				t.Errorf("%s: invalid spdx-id accepted", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		found := false
+		for _, template := range detector.templates {
+			if template.Title == "Acme License" {
+				found = true
+				if template.SPDXID != test.spdxID {
+					t.Errorf("%s: spdx-id %s, want %s", test.name, template.SPDXID, test.spdxID)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("%s: template not loaded", test.name)
+		}
+	}
+}